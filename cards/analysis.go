@@ -0,0 +1,30 @@
+package cards
+
+// CategoryDistribution deals the specified number of random seven card hands and counts how many
+// times the best five card hand falls into each category.
+func CategoryDistribution(deals int) map[HandCategory]int {
+	distribution := make(map[HandCategory]int)
+	for i := 0; i < deals; i++ {
+		deck := GenerateDeck()
+		distribution[Category(deck.cards[:7])]++
+	}
+	return distribution
+}
+
+var exampleHands = map[HandCategory]Hand{
+	HighCard:      {{King, Spade}, {Jack, Heart}, {Eight, Club}, {Five, Diamond}, {Two, Spade}},
+	Pair:          {{Ten, Spade}, {Ten, Heart}, {Ace, Club}, {Seven, Diamond}, {Three, Spade}},
+	TwoPair:       {{Jack, Heart}, {Jack, Club}, {Four, Spade}, {Four, Diamond}, {Nine, Heart}},
+	ThreeOfAKind:  {{Seven, Spade}, {Seven, Heart}, {Seven, Diamond}, {King, Club}, {Two, Heart}},
+	Straight:      {{Six, Club}, {Seven, Diamond}, {Eight, Spade}, {Nine, Heart}, {Ten, Club}},
+	Flush:         {{Ace, Diamond}, {Jack, Diamond}, {Eight, Diamond}, {Six, Diamond}, {Three, Diamond}},
+	FullHouse:     {{Queen, Spade}, {Queen, Heart}, {Queen, Club}, {Five, Diamond}, {Five, Spade}},
+	FourOfAKind:   {{Nine, Spade}, {Nine, Heart}, {Nine, Club}, {Nine, Diamond}, {Ace, Spade}},
+	StraightFlush: {{Five, Heart}, {Six, Heart}, {Seven, Heart}, {Eight, Heart}, {Nine, Heart}},
+}
+
+// ExampleHand returns a representative five card hand of the specified category, which is useful for
+// legends and teaching tools. It returns an empty hand if the category is unknown.
+func ExampleHand(category HandCategory) Hand {
+	return append(Hand{}, exampleHands[category]...)
+}
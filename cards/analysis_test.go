@@ -0,0 +1,59 @@
+package cards
+
+import (
+	"math"
+	"testing"
+)
+
+// Tests that the evaluator finds each category about as often as it occurs in seven card poker.
+func TestCategoryDistribution(t *testing.T) {
+	deals := 100000
+	// Probability of each category being the best hand in seven cards.
+	expected := map[HandCategory]float64{
+		HighCard:      0.1741,
+		Pair:          0.4382,
+		TwoPair:       0.2350,
+		ThreeOfAKind:  0.0483,
+		Straight:      0.0462,
+		Flush:         0.0303,
+		FullHouse:     0.0260,
+		FourOfAKind:   0.00168,
+		StraightFlush: 0.000311,
+	}
+	// Allow five standard deviations of sampling error.
+	distribution := CategoryDistribution(deals)
+	total := 0
+	for category, p := range expected {
+		frequency := float64(distribution[category]) / float64(deals)
+		tolerance := 5 * math.Sqrt(p*(1-p)/float64(deals))
+		if math.Abs(frequency-p) > tolerance {
+			t.Errorf("Expected %v to occur with frequency %v, but instead it occurred with frequency %v.", category, p, frequency)
+		}
+		total += distribution[category]
+	}
+	if total != deals {
+		t.Errorf("Expected %v hands to be counted, but instead %v were.", deals, total)
+	}
+}
+
+func TestExampleHand(t *testing.T) {
+	for category := HighCard; category <= StraightFlush; category++ {
+		hand := ExampleHand(category)
+		if len(hand) != 5 {
+			t.Errorf("Expected the example %v to have 5 cards, but instead it had %v.", category, len(hand))
+		}
+		seen := make(map[Card]bool)
+		for _, c := range hand {
+			if seen[c] {
+				t.Errorf("Expected the example %v to have distinct cards, but %v appears twice.", category, c)
+			}
+			seen[c] = true
+		}
+		if actual := Category(hand); actual != category {
+			t.Errorf("Expected the example %v to evaluate to %v, but instead it evaluated to %v.", category, category, actual)
+		}
+	}
+	if hand := ExampleHand(HandCategory(-1)); len(hand) != 0 {
+		t.Errorf("Expected an unknown category to have no example hand, but instead it had %v.", hand)
+	}
+}
@@ -0,0 +1,75 @@
+package cards
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CanonicalID returns a number that identifies a set of cards regardless of the order they are in, so
+// the same cards always have the same id. It is the CardSet of the cards. It returns an error if a card
+// isn't one of the 52 playing cards or appears more than once.
+func CanonicalID(cards []Card) (uint64, error) {
+	var set CardSet
+	for _, c := range cards {
+		if cardIndex(c) == -1 {
+			return 0, fmt.Errorf("error creating canonical id: %v is not a valid card", c)
+		}
+		if set.Contains(c) {
+			return 0, fmt.Errorf("error creating canonical id: %v appears more than once", c)
+		}
+		set.Add(c)
+	}
+	return uint64(set), nil
+}
+
+// EvalCache remembers the scores of hands an Evaluator has already evaluated, keyed by their
+// CanonicalID, so evaluating the same cards again, as happens in repeated simulated showdowns, doesn't
+// recompute them. Once it holds its maximum number of hands, new hands are evaluated without being
+// cached. It is safe for concurrent use.
+type EvalCache struct {
+	mu      sync.Mutex
+	scores  map[evalCacheKey]int
+	maxSize int
+}
+
+// evalCacheKey identifies a set of cards evaluated with an ace mode, since the ace mode can change
+// the score of the same cards.
+type evalCacheKey struct {
+	id   uint64
+	aces AceMode
+}
+
+// NewEvalCache creates an empty cache that holds at most maxSize hands.
+func NewEvalCache(maxSize int) *EvalCache {
+	return &EvalCache{scores: make(map[evalCacheKey]int), maxSize: maxSize}
+}
+
+// Len returns the number of hands in the cache.
+func (c *EvalCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.scores)
+}
+
+// score returns the score of the cards, from the cache if they have been evaluated before. Cards that
+// don't have a canonical id are evaluated without being cached.
+func (c *EvalCache) score(cards []Card, aces AceMode) int {
+	id, err := CanonicalID(cards)
+	if err != nil {
+		return evaluate(cards, aces).score()
+	}
+	key := evalCacheKey{id, aces}
+	c.mu.Lock()
+	score, ok := c.scores[key]
+	c.mu.Unlock()
+	if ok {
+		return score
+	}
+	score = evaluate(cards, aces).score()
+	c.mu.Lock()
+	if len(c.scores) < c.maxSize {
+		c.scores[key] = score
+	}
+	c.mu.Unlock()
+	return score
+}
@@ -0,0 +1,73 @@
+package cards
+
+import "testing"
+
+func TestCanonicalID(t *testing.T) {
+	a := []Card{{Ace, Spade}, {King, Heart}, {Two, Club}}
+	b := []Card{{Two, Club}, {Ace, Spade}, {King, Heart}}
+	idA, errA := CanonicalID(a)
+	idB, errB := CanonicalID(b)
+	if errA != nil || errB != nil || idA != idB {
+		t.Errorf("Expected the same cards in a different order to have the same id, but instead they were %v and %v with errors %v and %v.",
+			idA, idB, errA, errB)
+	}
+	if idC, _ := CanonicalID([]Card{{Ace, Spade}, {King, Heart}, {Three, Club}}); idC == idA {
+		t.Errorf("Expected different cards to have different ids, but both were %v.", idA)
+	}
+	invalid := [][]Card{
+		{{Ace, Spade}, {Ace, Spade}},
+		{{1, Spade}},
+		{{Ace, "Stars"}},
+	}
+	for _, test := range invalid {
+		if _, err := CanonicalID(test); err == nil {
+			t.Errorf("Expected CanonicalID(%v) to return an error, but it didn't.", test)
+		}
+	}
+}
+
+func TestEvalCache(t *testing.T) {
+	cache := NewEvalCache(2)
+	e := Evaluator{Cache: cache}
+	hands := [][]Card{
+		{{Ace, Spade}, {Two, Club}, {Three, Heart}, {Four, Diamond}, {Five, Spade}},
+		{{Five, Spade}, {Four, Diamond}, {Three, Heart}, {Two, Club}, {Ace, Spade}},
+		{{King, Spade}, {King, Club}, {Three, Heart}, {Four, Diamond}, {Five, Spade}},
+		{{Queen, Spade}, {Queen, Club}, {Three, Heart}, {Four, Diamond}, {Five, Spade}},
+	}
+	for _, hand := range hands {
+		if score, expected := e.Score(hand), Score(hand); score != expected {
+			t.Errorf("Expected the cached score of %v to be %v, but instead it was %v.", hand, expected, score)
+		}
+	}
+	// The first two hands are the same cards, and the last hand doesn't fit.
+	if cache.Len() != 2 {
+		t.Errorf("Expected the cache to hold 2 hands, but instead it held %v.", cache.Len())
+	}
+	// The wheel is a straight with aces high or low, but not with aces high only.
+	highOnly := Evaluator{Aces: AceHighOnly, Cache: NewEvalCache(10)}
+	highOnly.Score(hands[0])
+	if score, expected := highOnly.Score(hands[0]), (Evaluator{Aces: AceHighOnly}).Score(hands[0]); score != expected {
+		t.Errorf("Expected the cached score with aces high only to be %v, but instead it was %v.", expected, score)
+	}
+}
+
+var benchmarkHands = [][]Card{
+	{{Ace, Spade}, {King, Spade}, {Queen, Heart}, {Jack, Diamond}, {Ten, Club}, {Two, Club}, {Seven, Heart}},
+	{{Nine, Heart}, {Nine, Club}, {Four, Heart}, {Four, Spade}, {Four, Diamond}, {Eight, Club}, {Jack, Heart}},
+	{{Three, Diamond}, {Six, Diamond}, {Eight, Diamond}, {Queen, Diamond}, {King, Club}, {Two, Heart}, {Ace, Diamond}},
+}
+
+func BenchmarkScore(b *testing.B) {
+	e := Evaluator{}
+	for i := 0; i < b.N; i++ {
+		e.Score(benchmarkHands[i%len(benchmarkHands)])
+	}
+}
+
+func BenchmarkScoreCached(b *testing.B) {
+	e := Evaluator{Cache: NewEvalCache(len(benchmarkHands))}
+	for i := 0; i < b.N; i++ {
+		e.Score(benchmarkHands[i%len(benchmarkHands)])
+	}
+}
@@ -1,16 +1,15 @@
 package cards
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/rand"
-	"time"
+	"sort"
+	"strings"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
 // Suit represents a cards suit. Ex Spade
 type Suit string
 
@@ -24,6 +23,30 @@ const (
 
 var suits = []Suit{Spade, Club, Heart, Diamond}
 
+var suitGlyphs = map[Suit]rune{
+	Spade:     '♠',
+	Club:      '♣',
+	Heart:     '♥',
+	Diamond:   '♦',
+	JokerSuit: '★',
+}
+
+// Color returns "red" for hearts and diamonds and "black" for spades and clubs.
+func (s Suit) Color() string {
+	if s == Heart || s == Diamond {
+		return "red"
+	}
+	return "black"
+}
+
+// Symbol returns the suit's glyph, Ex. ♠ for Spades, or ? if the suit isn't known.
+func (s Suit) Symbol() rune {
+	if symbol, ok := suitGlyphs[s]; ok {
+		return symbol
+	}
+	return '?'
+}
+
 // Rank represents a cards value. Ex. Jack
 type Rank int8
 
@@ -44,6 +67,37 @@ const (
 	Ace   Rank = 14
 )
 
+var rankNames = map[Rank]string{
+	Two:   "Two",
+	Three: "Three",
+	Four:  "Four",
+	Five:  "Five",
+	Six:   "Six",
+	Seven: "Seven",
+	Eight: "Eight",
+	Nine:  "Nine",
+	Ten:   "Ten",
+	Jack:  "Jack",
+	Queen: "Queen",
+	King:  "King",
+	Ace:   "Ace",
+}
+
+func (r Rank) String() string {
+	if name, ok := rankNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Rank(%d)", int8(r))
+}
+
+// plural returns the plural name of the rank. Ex. Sixes
+func (r Rank) plural() string {
+	if r == Six {
+		return "Sixes"
+	}
+	return r.String() + "s"
+}
+
 type handRank int16
 
 // Poker hand ranks mapped to arbitrary values with descending order based on rank
@@ -51,20 +105,86 @@ const (
 	royalFlushRank    handRank = 100
 	straightFlushRank handRank = 99
 	fourOfAKindRank   handRank = 98
-	flushRank         handRank = 97
-	straightRank      handRank = 96
-	threeOfAKindRank  handRank = 95
-	twoPairRank       handRank = 94
-	pairRank          handRank = 93
-	highCardRank      handRank = 92
+	fullHouseRank     handRank = 97
+	flushRank         handRank = 96
+	straightRank      handRank = 95
+	threeOfAKindRank  handRank = 94
+	twoPairRank       handRank = 93
+	pairRank          handRank = 92
+	highCardRank      handRank = 91
+)
+
+// HandCategory represents the category of a poker hand. Ex. Flush
+type HandCategory int8
+
+// Hand categories, ordered from lowest to highest. A royal flush is an ace high StraightFlush.
+const (
+	HighCard HandCategory = iota
+	Pair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
 )
 
+var categoryNames = map[HandCategory]string{
+	HighCard:      "High Card",
+	Pair:          "Pair",
+	TwoPair:       "Two Pair",
+	ThreeOfAKind:  "Three of a Kind",
+	Straight:      "Straight",
+	Flush:         "Flush",
+	FullHouse:     "Full House",
+	FourOfAKind:   "Four of a Kind",
+	StraightFlush: "Straight Flush",
+}
+
+func (c HandCategory) String() string {
+	return categoryNames[c]
+}
+
+var categoriesByRank = map[handRank]HandCategory{
+	royalFlushRank:    StraightFlush,
+	straightFlushRank: StraightFlush,
+	fourOfAKindRank:   FourOfAKind,
+	fullHouseRank:     FullHouse,
+	flushRank:         Flush,
+	straightRank:      Straight,
+	threeOfAKindRank:  ThreeOfAKind,
+	twoPairRank:       TwoPair,
+	pairRank:          Pair,
+	highCardRank:      HighCard,
+}
+
 // Card represents a playing card.
 type Card struct {
 	rank Rank
 	suit Suit
 }
 
+// NewCard returns a playing card with the specified rank and suit.
+func NewCard(rank Rank, suit Suit) Card {
+	return Card{rank, suit}
+}
+
+// Rank returns the rank of the card.
+func (c Card) Rank() Rank {
+	return c.rank
+}
+
+// Suit returns the suit of the card.
+func (c Card) Suit() Suit {
+	return c.suit
+}
+
+// Equals returns true if the cards have the same rank and suit.
+func (c Card) Equals(other Card) bool {
+	return c.rank == other.rank && c.suit == other.suit
+}
+
 type Hand []Card
 
 // Implement the sort.Interface so that we can sort a hand.
@@ -72,14 +192,114 @@ func (h Hand) Len() int           { return len(h) }
 func (h Hand) Less(a, b int) bool { return h[a].rank < h[b].rank }
 func (h Hand) Swap(a, b int)      { h[a], h[b] = h[b], h[a] }
 
+// SuitOrder returns the position of the suit in the order used to break ties between cards of the same
+// rank, from lowest to highest: Clubs, Diamonds, Hearts, then Spades. Jokers come before every suit.
+func SuitOrder(s Suit) int {
+	switch s {
+	case Club:
+		return 0
+	case Diamond:
+		return 1
+	case Heart:
+		return 2
+	case Spade:
+		return 3
+	}
+	return -1
+}
+
+// Sorted returns a copy of the hand ordered from the highest card to the lowest, by rank and then by
+// SuitOrder, so that hands with the same cards are sorted the same way.
+func (h Hand) Sorted() Hand {
+	sorted := append(Hand{}, h...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].rank != sorted[j].rank {
+			return sorted[i].rank > sorted[j].rank
+		}
+		return SuitOrder(sorted[i].suit) > SuitOrder(sorted[j].suit)
+	})
+	return sorted
+}
+
+// String returns the cards of the hand in the notation of ParseCard, separated by spaces and in the order
+// of Sorted, so that hands with the same cards have the same string. Ex. "As Kh Kd 7c 2s"
+func (h Hand) String() string {
+	notations := []string{}
+	for _, c := range h.Sorted() {
+		notations = append(notations, formatCard(c))
+	}
+	return strings.Join(notations, " ")
+}
+
+// HandsEqual returns true if the hands contain equal cards in the same order.
+func HandsEqual(a, b Hand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // Deck represents a deck of cards.
 type Deck struct {
-	cards []Card
+	cards  []Card
+	seed   int64 // seed used to shuffle the deck, only set for committed decks
+	jokers int   // number of jokers the deck started with
 }
 
-// GenerateDeck returns a Deck of 52 shuffled playing cards.
+// GenerateDeck returns a Deck of 52 playing cards shuffled with a source seeded from the current time.
 func GenerateDeck() Deck {
-	suits := []Suit{Spade, Club, Heart, Diamond}
+	return GenerateDeckFrom(rand.New(NewTimeSource()))
+}
+
+// GenerateDeckFrom returns a Deck of 52 playing cards shuffled with r, so that the same source shuffles
+// the same deck.
+func GenerateDeckFrom(r *rand.Rand) Deck {
+	shuffledCards := shuffle(AllCards(), r.Intn)
+	deck := Deck{cards: shuffledCards}
+	return deck
+}
+
+// GenerateDeckCommitted returns a Deck of 52 playing cards shuffled deterministically from seed,
+// along with a commitment (a hex encoded SHA-256 hash of the seed and the shuffled ordering).
+// The commitment can be published before any cards are dealt and the seed revealed afterwards
+// with RevealSeed, allowing players to verify with VerifyCommitment that the deck was not changed.
+func GenerateDeckCommitted(seed int64) (Deck, string) {
+	r := rand.New(rand.NewSource(seed))
+	shuffledCards := shuffle(AllCards(), r.Intn)
+	deck := Deck{cards: shuffledCards, seed: seed}
+	return deck, commitment(seed, shuffledCards)
+}
+
+// RevealSeed returns the seed that was used to shuffle a deck created by GenerateDeckCommitted.
+func (deck Deck) RevealSeed() int64 {
+	return deck.seed
+}
+
+// VerifyCommitment returns true if shuffling a deck with the revealed seed produces the ordering
+// described by the commitment returned from GenerateDeckCommitted.
+func VerifyCommitment(seed int64, commitmentHash string) bool {
+	_, expected := GenerateDeckCommitted(seed)
+	return expected == commitmentHash
+}
+
+// commitment returns the hex encoded SHA-256 hash of the seed followed by the ordering of the cards.
+func commitment(seed int64, cards []Card) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d", seed)
+	for _, c := range cards {
+		fmt.Fprintf(h, ":%d%s", c.rank, c.suit)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AllCards returns the 52 playing cards in a fixed, unshuffled order: Spades, Clubs, Hearts, then
+// Diamonds, with each suit ordered from Two to Ace.
+func AllCards() []Card {
 	ranks := []Rank{Two, Three, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace}
 	cards := make([]Card, 0)
 	for _, s := range suits {
@@ -88,16 +308,15 @@ func GenerateDeck() Deck {
 			cards = append(cards, c)
 		}
 	}
-	shuffledCards := shuffle(cards)
-	deck := Deck{shuffledCards}
-	return deck
+	return cards
 }
 
-func shuffle(cards []Card) []Card {
+// shuffle returns the cards in a random order, using intn to pick a random index in [0, n).
+func shuffle(cards []Card, intn func(n int) int) []Card {
 	shuffledDeck := []Card{}
 	i := len(cards)
 	for i > 0 {
-		rand_idx := rand.Intn(len(cards))
+		rand_idx := intn(len(cards))
 		// Add randomly selected card to new deck.
 		c := cards[rand_idx]
 		shuffledDeck = append(shuffledDeck, c)
@@ -111,11 +330,22 @@ func shuffle(cards []Card) []Card {
 	return shuffledDeck
 }
 
-// Length returns the number of cards in the deck.
+// Length returns the number of cards in the deck. It is the same as Remaining.
 func (deck Deck) Length() int {
 	return len(deck.cards)
 }
 
+// Remaining returns the number of cards left in the deck.
+func (deck Deck) Remaining() int {
+	return len(deck.cards)
+}
+
+// DealtCount returns the number of cards that are no longer in the deck, either because they have been
+// drawn or removed.
+func (deck Deck) DealtCount() int {
+	return len(AllCards()) + deck.jokers - deck.Remaining()
+}
+
 // Draw removes and returns the last card from the deck.
 func (deck *Deck) Draw() (Card, error) {
 	if deck.Length() == 0 {
@@ -130,16 +360,93 @@ func (deck Deck) GetCards() []Card {
 	return deck.cards
 }
 
-func getHandRank(hand []Card) handRank {
-	if hasRoyalFlush, rank := royalFlush(hand); hasRoyalFlush {
-		return rank
-	} else if hasStraightFlush, rank := straightFlush(hand); hasStraightFlush {
+// Remove removes the specified card from the deck, returning an error if the card isn't in the deck.
+func (deck *Deck) Remove(c Card) error {
+	for idx, card := range deck.cards {
+		if card == c {
+			remaining, err := removeCard(deck.cards, idx)
+			if err != nil {
+				return err
+			}
+			deck.cards = remaining
+			return nil
+		}
+	}
+	return fmt.Errorf("%v is not in the deck", c)
+}
+
+// Cut splits the deck into the cards before the position and the cards from the position onwards, and
+// swaps the two halves. The position must leave at least one card in each half.
+func (deck *Deck) Cut(position int) error {
+	if position < 1 || position >= deck.Length() {
+		return fmt.Errorf("can't cut a deck of %v cards at position %v", deck.Length(), position)
+	}
+	deck.cards = append(append([]Card{}, deck.cards[position:]...), deck.cards[:position]...)
+	return nil
+}
+
+// Clone returns a copy of the deck that can be drawn from without affecting the original.
+func (deck Deck) Clone() Deck {
+	clone := deck
+	clone.cards = append([]Card{}, deck.cards...)
+	return clone
+}
+
+// AceMode determines whether an ace can be used as the low card of a straight, the high card, or both.
+type AceMode int8
+
+const (
+	// AceHighOrLow lets an ace be used as either the low card (A-2-3-4-5) or the high card (10-J-Q-K-A) of a straight.
+	AceHighOrLow AceMode = 0
+	// AceHighOnly only lets an ace be used as the high card of a straight.
+	AceHighOnly AceMode = 1
+	// AceLowOnly only lets an ace be used as the low card of a straight.
+	AceLowOnly AceMode = 2
+)
+
+// Evaluator evaluates poker hands according to configurable rules for variant games.
+// The zero value evaluates hands using the standard rules.
+type Evaluator struct {
+	// Aces determines how an ace can be used in straights and straight flushes. It doesn't affect
+	// how aces rank as kickers or in pairs.
+	Aces AceMode
+	// Cache, if set, remembers the scores of hands that have already been evaluated so CompareHands and
+	// Score don't recompute them. It is nil by default, since the cache grows with every new hand.
+	Cache *EvalCache
+}
+
+// Category returns the category of the best five card hand that can be made from the cards.
+func Category(hand []Card) HandCategory {
+	return Evaluator{}.Category(hand)
+}
+
+// Category returns the category of the best five card hand that can be made from the cards.
+func (e Evaluator) Category(hand []Card) HandCategory {
+	return categoriesByRank[getHandRank(hand, e.Aces)]
+}
+
+// getHandRank returns the rank of the best hand that can be made from the cards. Every hand check
+// reports no match for nil or empty cards, so they rank as a high card.
+// Jokers are replaced by the cards that make the best hand before the hand is checked.
+func getHandRank(hand []Card, aces AceMode) handRank {
+	if len(hand) == 0 {
+		return highCardRank
+	}
+	if hasJoker(hand) {
+		hand = replaceJokers(hand, aces)
+	}
+	hasRoyalFlush, royalRank := royalFlush(hand)
+	if hasRoyalFlush && aces != AceLowOnly {
+		return royalRank
+	} else if hasStraightFlush, rank := straightFlush(hand, aces); hasStraightFlush {
 		return rank
 	} else if hasFourOfAKind, rank := fourOfAKind(hand); hasFourOfAKind {
 		return rank
+	} else if hasFullHouse, rank := fullHouse(hand); hasFullHouse {
+		return rank
 	} else if hasFlush, rank := flush(hand); hasFlush {
 		return rank
-	} else if hasStraight, rank := straight(hand); hasStraight {
+	} else if hasStraight, rank := straight(hand, aces); hasStraight {
 		return rank
 	} else if hasThreeOfAKind, rank := threeOfAKind(hand); hasThreeOfAKind {
 		return rank
@@ -153,9 +460,15 @@ func getHandRank(hand []Card) handRank {
 }
 
 func royalFlush(hand []Card) (bool, handRank) {
-	tens := getCardsByRank(hand, Ten)
-	for _, t := range tens {
-		hasRoyalFlush := royalFlushSearch(hand, t.suit, t)
+	set := NewCardSet(hand)
+	for _, suit := range suits {
+		hasRoyalFlush := true
+		for _, rank := range []Rank{Ten, Jack, Queen, King, Ace} {
+			if !set.Contains(Card{rank, suit}) {
+				hasRoyalFlush = false
+				break
+			}
+		}
 		if hasRoyalFlush {
 			return true, royalFlushRank
 		}
@@ -163,41 +476,7 @@ func royalFlush(hand []Card) (bool, handRank) {
 	return false, 0
 }
 
-// royalFlushSearch performs a depth first search for a Royal Flush in a slice of cards.
-// hand is the deck of cards to search, current is the card to start the search at (root node),
-// and suit is the suit of the starting card.
-func royalFlushSearch(hand []Card, suit Suit, current Card) bool {
-	if current.rank == Ten && current.suit == suit {
-		idx, jack := cardSearchByRankAndSuit(hand, Jack, suit)
-		if idx == -1 {
-			return false
-		}
-		return royalFlushSearch(hand, suit, jack)
-	} else if current.rank == Jack && current.suit == suit {
-		idx, queen := cardSearchByRankAndSuit(hand, Queen, suit)
-		if idx == -1 {
-			return false
-		}
-		return royalFlushSearch(hand, suit, queen)
-	} else if current.rank == Queen && current.suit == suit {
-		idx, king := cardSearchByRankAndSuit(hand, King, suit)
-		if idx == -1 {
-			return false
-		}
-		return royalFlushSearch(hand, suit, king)
-	} else if current.rank == King && current.suit == suit {
-		idx, ace := cardSearchByRankAndSuit(hand, Ace, suit)
-		if idx == -1 {
-			return false
-		}
-		return royalFlushSearch(hand, suit, ace)
-	} else if current.rank == Ace && current.suit == suit {
-		return true
-	}
-	return false
-}
-
-func straightFlush(hand []Card) (bool, handRank) {
+func straightFlush(hand []Card, aces AceMode) (bool, handRank) {
 	// map of suits to array of bools that indicate whether or not a card exists.
 	// index 0 represents an ace.
 	cardMapAceLow := createCardMap()
@@ -215,7 +494,7 @@ func straightFlush(hand []Card) (bool, handRank) {
 		cardMapAceHigh[card.suit][card.rank-2] = true
 	}
 
-	if fiveInARow(cardMapAceLow) || fiveInARow(cardMapAceHigh) {
+	if (aces != AceHighOnly && fiveInARow(cardMapAceLow)) || (aces != AceLowOnly && fiveInARow(cardMapAceHigh)) {
 		return true, straightFlushRank
 	}
 	return false, 0
@@ -260,67 +539,89 @@ func fourOfAKind(hand []Card) (bool, handRank) {
 	return false, 0
 }
 
+func fullHouse(hand []Card) (bool, handRank) {
+	cardCounts := cardCountsByRank(hand)
+	threes := 0
+	pairs := 0
+	for _, count := range cardCounts {
+		if count >= 3 {
+			threes++
+		} else if count == 2 {
+			pairs++
+		}
+	}
+	// A second three of a kind can be used as the pair.
+	if threes >= 2 || (threes == 1 && pairs >= 1) {
+		return true, fullHouseRank
+	}
+	return false, 0
+}
+
 func flush(hand []Card) (bool, handRank) {
 	suitCounts := cardCountsBySuit(hand)
 	for _, v := range suitCounts {
-		if v == 5 {
+		if v >= 5 {
 			return true, flushRank
 		}
 	}
 	return false, 0
 }
 
-func straight(hand []Card) (bool, handRank) {
+func straight(hand []Card, aces AceMode) (bool, handRank) {
 	if len(hand) < 5 {
 		return false, 0
 	}
-	// Check for straight with Ace as low card
-	orderedHandAceLow := orderByRank(hand, true)
-	consecutiveCount := 1
-	prev := orderedHandAceLow[0]
-	i := 1
-	for i < len(hand) {
-		currentRank := orderedHandAceLow[i].rank
-		prevRank := prev.rank
-		// Treat ace as low card
-		if orderedHandAceLow[i].rank == Ace {
-			currentRank = 1
-		}
-		if prev.rank == Ace {
-			prevRank = 1
-		}
+	if aces != AceHighOnly {
+		// Check for straight with Ace as low card
+		orderedHandAceLow := orderByRank(hand, true)
+		consecutiveCount := 1
+		prev := orderedHandAceLow[0]
+		i := 1
+		for i < len(hand) {
+			currentRank := orderedHandAceLow[i].rank
+			prevRank := prev.rank
+			// Treat ace as low card
+			if orderedHandAceLow[i].rank == Ace {
+				currentRank = 1
+			}
+			if prev.rank == Ace {
+				prevRank = 1
+			}
 
-		// Increment count, reset count, or do nothing (The case when currentRank == prevRank)
-		if currentRank == prevRank+1 {
-			consecutiveCount++
-		} else if currentRank > prevRank+1 {
-			consecutiveCount = 1
-		}
-		prev = orderedHandAceLow[i]
-		i += 1
+			// Increment count, reset count, or do nothing (The case when currentRank == prevRank)
+			if currentRank == prevRank+1 {
+				consecutiveCount++
+			} else if currentRank > prevRank+1 {
+				consecutiveCount = 1
+			}
+			prev = orderedHandAceLow[i]
+			i += 1
 
-		if consecutiveCount == 5 {
-			return true, straightRank
+			if consecutiveCount == 5 {
+				return true, straightRank
+			}
 		}
 	}
 
-	// Check for straight with Ace as high card
-	orderedHandAceHigh := orderByRank(hand, false)
-	consecutiveCount = 1
-	prev = orderedHandAceHigh[0]
-	i = 1
-	for i < len(hand) {
-		currentRank := orderedHandAceHigh[i].rank
-		if currentRank == prev.rank+1 {
-			consecutiveCount++
-		} else if currentRank > prev.rank+1 {
-			consecutiveCount = 1
-		}
-		prev = orderedHandAceHigh[i]
-		i += 1
+	if aces != AceLowOnly {
+		// Check for straight with Ace as high card
+		orderedHandAceHigh := orderByRank(hand, false)
+		consecutiveCount := 1
+		prev := orderedHandAceHigh[0]
+		i := 1
+		for i < len(hand) {
+			currentRank := orderedHandAceHigh[i].rank
+			if currentRank == prev.rank+1 {
+				consecutiveCount++
+			} else if currentRank > prev.rank+1 {
+				consecutiveCount = 1
+			}
+			prev = orderedHandAceHigh[i]
+			i += 1
 
-		if consecutiveCount == 5 {
-			return true, straightRank
+			if consecutiveCount == 5 {
+				return true, straightRank
+			}
 		}
 	}
 	return false, 0
@@ -360,8 +661,9 @@ func pair(hand []Card) (bool, handRank) {
 	return false, 0
 }
 
+// highCard returns the rank of the highest card in the hand, or 0 if the hand is empty.
 func highCard(hand []Card) Rank {
-	high := Rank(-1)
+	high := Rank(0)
 	for _, card := range hand {
 		if card.rank > high {
 			high = card.rank
@@ -370,6 +672,315 @@ func highCard(hand []Card) Rank {
 	return high
 }
 
+// handValue is the evaluation of the best five card hand that can be made from a set of cards.
+type handValue struct {
+	rank handRank
+	// ranks used to break ties between hands with the same handRank, ordered from most to least significant.
+	tiebreakers []Rank
+}
+
+// CompareHands compares the best five card hands that can be made from a and b.
+// It returns 1 if a is the better hand, -1 if b is the better hand, and 0 if they tie.
+func CompareHands(a, b []Card) int {
+	return Evaluator{}.CompareHands(a, b)
+}
+
+// CompareHands compares the best five card hands that can be made from a and b.
+// It returns 1 if a is the better hand, -1 if b is the better hand, and 0 if they tie.
+func (e Evaluator) CompareHands(a, b []Card) int {
+	aScore, bScore := e.score(a), e.score(b)
+	if aScore > bScore {
+		return 1
+	} else if aScore < bScore {
+		return -1
+	}
+	return 0
+}
+
+// BestHand returns the best five card hand that can be made from the cards. If there are five or
+// fewer cards, all of them are returned. When several hands tie for the best, the first found is returned.
+func BestHand(cards []Card) Hand {
+	if len(cards) <= 5 {
+		return append(Hand{}, cards...)
+	}
+	best := Hand{}
+	var bestValue handValue
+	ForEachCombination(cards, 5, func(combo []Card) {
+		value := evaluate(combo, AceHighOrLow)
+		if len(best) == 0 || compareHandValues(value, bestValue) > 0 {
+			best = append(Hand{}, combo...)
+			bestValue = value
+		}
+	})
+	return best
+}
+
+// CompareOnBoard compares the best hands that two players holding the hole cards a and b can make
+// using the shared board. It returns 1 if a makes the better hand, -1 if b does, and 0 if they tie.
+func CompareOnBoard(a, b, board []Card) int {
+	aCards := append(append([]Card{}, a...), board...)
+	bCards := append(append([]Card{}, b...), board...)
+	return CompareHands(aCards, bCards)
+}
+
+// Score returns a number encoding the category and tiebreakers of the best five card hand that can be
+// made from the cards, such that a better hand always has a higher score and tied hands have equal scores.
+//
+// The HandCategory is stored in bits 20 to 23. The ranks that break ties within the category follow in
+// four bit groups from most to least significant, starting at bits 16 to 19, with unused groups set to
+// 0. Ex. a pair of Sixes with a King, Nine, and Two is 0x16D920.
+func Score(cards []Card) int {
+	return Evaluator{}.Score(cards)
+}
+
+// Score returns a number encoding the category and tiebreakers of the best five card hand that can be
+// made from the cards, using the same layout as the package level Score.
+func (e Evaluator) Score(cards []Card) int {
+	return e.score(cards)
+}
+
+// score returns the score of the cards, consulting the evaluator's cache if it has one.
+func (e Evaluator) score(cards []Card) int {
+	if e.Cache != nil {
+		return e.Cache.score(cards, e.Aces)
+	}
+	return evaluate(cards, e.Aces).score()
+}
+
+// score encodes the hand value as described by Score.
+func (v handValue) score() int {
+	score := int(categoriesByRank[v.rank])
+	for i := 0; i < 5; i++ {
+		score <<= 4
+		if i < len(v.tiebreakers) {
+			score |= int(v.tiebreakers[i])
+		}
+	}
+	return score
+}
+
+func compareHandValues(a, b handValue) int {
+	aScore, bScore := a.score(), b.score()
+	if aScore > bScore {
+		return 1
+	} else if aScore < bScore {
+		return -1
+	}
+	return 0
+}
+
+// evaluate returns the rank of the best five card hand that can be made from the cards along with
+// the ranks needed to break a tie against another hand of the same rank.
+func evaluate(hand []Card, aces AceMode) handValue {
+	if hasJoker(hand) {
+		hand = replaceJokers(hand, aces)
+	}
+	rank := getHandRank(hand, aces)
+	counts := cardCountsByRank(hand)
+	var tiebreakers []Rank
+	switch rank {
+	case royalFlushRank, straightFlushRank:
+		tiebreakers = []Rank{straightFlushHighCard(hand, aces)}
+	case fourOfAKindRank:
+		quads := ranksWithCount(counts, 4)
+		tiebreakers = append(quads[:1], highestRanks(hand, 1, quads[0])...)
+	case fullHouseRank:
+		threes := ranksWithCount(counts, 3)
+		// The pair is the highest other rank appearing at least twice, which may be a second three of a kind.
+		pairs := []Rank{}
+		for _, r := range ranksWithCount(counts, 2) {
+			if r != threes[0] {
+				pairs = append(pairs, r)
+			}
+		}
+		tiebreakers = []Rank{threes[0], pairs[0]}
+	case flushRank:
+		tiebreakers = highestRanks(cardsOfSuit(hand, flushSuit(hand)), 5)
+	case straightRank:
+		tiebreakers = []Rank{straightHighCard(hand, aces)}
+	case threeOfAKindRank:
+		threes := ranksWithCount(counts, 3)
+		tiebreakers = append(threes[:1], highestRanks(hand, 2, threes[0])...)
+	case twoPairRank:
+		pairs := ranksWithCount(counts, 2)[:2]
+		tiebreakers = append(pairs, highestRanks(hand, 1, pairs...)...)
+	case pairRank:
+		pairs := ranksWithCount(counts, 2)
+		tiebreakers = append(pairs[:1], highestRanks(hand, 3, pairs[0])...)
+	default:
+		tiebreakers = highestRanks(hand, 5)
+	}
+	return handValue{rank, tiebreakers}
+}
+
+// straightHighCard returns the rank of the highest card of the best straight in a slice of cards,
+// or 0 if there is no straight. The high card of an ace low straight is a Five.
+func straightHighCard(hand []Card, aces AceMode) Rank {
+	// index 1 represents an ace when it is used as the low card.
+	var present [Ace + 1]bool
+	for _, c := range hand {
+		if c.rank != Ace {
+			present[c.rank] = true
+			continue
+		}
+		present[1] = aces != AceHighOnly
+		present[Ace] = aces != AceLowOnly
+	}
+	for high := Ace; high >= Five; high-- {
+		hasStraight := true
+		for r := high; r > high-5; r-- {
+			if !present[r] {
+				hasStraight = false
+				break
+			}
+		}
+		if hasStraight {
+			return high
+		}
+	}
+	return 0
+}
+
+// straightFlushHighCard returns the rank of the highest card of the best straight flush in a slice
+// of cards, or 0 if there is no straight flush.
+func straightFlushHighCard(hand []Card, aces AceMode) Rank {
+	high := Rank(0)
+	for _, s := range suits {
+		if r := straightHighCard(cardsOfSuit(hand, s), aces); r > high {
+			high = r
+		}
+	}
+	return high
+}
+
+// flushSuit returns the suit that has at least five cards in a slice of cards, or an empty suit if
+// there is no flush.
+func flushSuit(hand []Card) Suit {
+	for s, count := range cardCountsBySuit(hand) {
+		if count >= 5 {
+			return s
+		}
+	}
+	return ""
+}
+
+func cardsOfSuit(cards []Card, suit Suit) []Card {
+	matches := []Card{}
+	for _, c := range cards {
+		if c.suit == suit {
+			matches = append(matches, c)
+		}
+	}
+	return matches
+}
+
+// ranksWithCount returns the ranks that appear at least count times in the card counts, from highest to lowest.
+func ranksWithCount(counts map[Rank]int, count int) []Rank {
+	ranks := []Rank{}
+	for r, c := range counts {
+		if c >= count {
+			ranks = append(ranks, r)
+		}
+	}
+	sortRanksDescending(ranks)
+	return ranks
+}
+
+// highestRanks returns the ranks of the n highest cards, ignoring cards with any of the excluded ranks.
+func highestRanks(cards []Card, n int, exclude ...Rank) []Rank {
+	ranks := []Rank{}
+	for _, c := range cards {
+		excluded := false
+		for _, r := range exclude {
+			if c.rank == r {
+				excluded = true
+			}
+		}
+		if !excluded {
+			ranks = append(ranks, c.rank)
+		}
+	}
+	sortRanksDescending(ranks)
+	if len(ranks) > n {
+		ranks = ranks[:n]
+	}
+	return ranks
+}
+
+func sortRanksDescending(ranks []Rank) {
+	sort.Slice(ranks, func(a, b int) bool { return ranks[a] > ranks[b] })
+}
+
+// Describe returns a description of the best five card hand that can be made from the cards,
+// including the ranks that decide it. Ex. "Full House, Kings full of Twos" or "Straight, Six to Ten".
+// An empty hand has no description.
+func Describe(h Hand) string {
+	if len(h) == 0 {
+		return ""
+	}
+	value := evaluate(h, AceHighOrLow)
+	ranks := value.tiebreakers
+	switch value.rank {
+	case royalFlushRank:
+		return "Royal Flush"
+	case straightFlushRank:
+		return fmt.Sprintf("%v, %v", StraightFlush, straightDescription(ranks[0]))
+	case fourOfAKindRank:
+		return fmt.Sprintf("%v, %v", FourOfAKind, ranks[0].plural())
+	case fullHouseRank:
+		return fmt.Sprintf("%v, %v full of %v", FullHouse, ranks[0].plural(), ranks[1].plural())
+	case flushRank:
+		return fmt.Sprintf("%v, %v high", Flush, ranks[0])
+	case straightRank:
+		return fmt.Sprintf("%v, %v", Straight, straightDescription(ranks[0]))
+	case threeOfAKindRank:
+		return fmt.Sprintf("%v, %v", ThreeOfAKind, ranks[0].plural())
+	case twoPairRank:
+		return fmt.Sprintf("%v, %v and %v", TwoPair, ranks[0].plural(), ranks[1].plural())
+	case pairRank:
+		return fmt.Sprintf("%v, %v", Pair, ranks[0].plural())
+	default:
+		return fmt.Sprintf("%v, %v", HighCard, ranks[0])
+	}
+}
+
+// CompareAndExplain compares the best five card hands that can be made from a and b like CompareHands,
+// and explains the result using Describe. Hands with the same description are decided by the first
+// rank that differs between them, which is usually a kicker.
+// Ex. "Hand A wins: Flush, Ace high beats Straight, Six to Ten" or
+// "Hand B wins: both have Pair, Sixes, but King beats Nine".
+func CompareAndExplain(a, b Hand) (int, string) {
+	result := CompareHands(a, b)
+	descA, descB := Describe(a), Describe(b)
+	winner, winningDesc, losingDesc := "A", descA, descB
+	winning, losing := evaluate(a, AceHighOrLow), evaluate(b, AceHighOrLow)
+	switch result {
+	case 0:
+		return 0, fmt.Sprintf("Tie: both have %v", descA)
+	case -1:
+		winner, winningDesc, losingDesc = "B", descB, descA
+		winning, losing = losing, winning
+	}
+	if winningDesc != losingDesc {
+		return result, fmt.Sprintf("Hand %v wins: %v beats %v", winner, winningDesc, losingDesc)
+	}
+	for i := range winning.tiebreakers {
+		if i < len(losing.tiebreakers) && winning.tiebreakers[i] != losing.tiebreakers[i] {
+			return result, fmt.Sprintf("Hand %v wins: both have %v, but %v beats %v", winner, winningDesc, winning.tiebreakers[i], losing.tiebreakers[i])
+		}
+	}
+	return result, fmt.Sprintf("Hand %v wins: both have %v", winner, winningDesc)
+}
+
+// straightDescription describes a straight by its lowest and highest cards. Ex. "Six to Ten"
+func straightDescription(high Rank) string {
+	low := high - 4
+	if high == Five {
+		low = Ace
+	}
+	return fmt.Sprintf("%v to %v", low, high)
+}
+
 // copyAndRemoveCard returns a copy of the cards passed to the function
 // minus the card at the specified index. The calling slice
 // of cards is unaffected.
@@ -415,15 +1026,6 @@ func cardSearchByRank(cards []Card, rank Rank) (int, Card) {
 	return -1, Card{}
 }
 
-func cardSearchByRankAndSuit(cards []Card, rank Rank, suit Suit) (int, Card) {
-	for idx, c := range cards {
-		if c.rank == rank && c.suit == suit {
-			return idx, c
-		}
-	}
-	return -1, Card{}
-}
-
 func orderByRank(cards []Card, aceLow bool) []Card {
 	// Copy contents of calling slice into new slice so that the original is unaffected.
 	cardsCopy := make([]Card, len(cards))
@@ -1,6 +1,9 @@
 package cards
 
-import "testing"
+import (
+	"sort"
+	"testing"
+)
 
 // Tests that highCard returns the rank of the highest card in a hand.
 func TestHighCard(t *testing.T) {
@@ -11,11 +14,11 @@ func TestHighCard(t *testing.T) {
 	}
 }
 
-// Tests that highCard returns -1 of the input hand is empty.
+// Tests that highCard returns 0 if the input hand is empty.
 func TestHighCardEmptyHand(t *testing.T) {
 	rank := highCard([]Card{})
-	if rank != -1 {
-		t.Errorf("Expected the rank of an empty hand to be -1 but instead it was %v.", rank)
+	if rank != 0 {
+		t.Errorf("Expected the rank of an empty hand to be 0 but instead it was %v.", rank)
 	}
 }
 
@@ -41,7 +44,7 @@ func TestRemoveCard(t *testing.T) {
 
 	for _, test := range tests {
 		cards, _ := removeCard(test.inputCards, test.inputIdx)
-		if !cardsEqual(cards, test.expected) {
+		if !HandsEqual(cards, test.expected) {
 			t.Errorf("Expected: %v Actual: %v", test.expected, cards)
 		}
 	}
@@ -71,7 +74,7 @@ func TestCopyAndRemoveCard(t *testing.T) {
 	inputCards := []Card{{Ten, Club}, {Two, Heart}, {Three, Club}, {Four, Diamond}, {Five, Spade}}
 	expectedCards := []Card{{Ten, Club}, {Three, Club}, {Four, Diamond}, {Five, Spade}}
 	cards, _ := copyAndRemoveCard(inputCards, 1)
-	if !cardsEqual(cards, expectedCards) {
+	if !HandsEqual(cards, expectedCards) {
 		t.Errorf("Expected: %v Actual: %v", expectedCards, cards)
 	}
 
@@ -91,7 +94,7 @@ func TestOrderByRankAceLow(t *testing.T) {
 	unordered := []Card{{King, Heart}, {Ace, Heart}, {Queen, Heart}, {Jack, Heart}, {Ten, Heart}, {Nine, Heart}}
 	ordered := []Card{{Ace, Heart}, {Nine, Heart}, {Ten, Heart}, {Jack, Heart}, {Queen, Heart}, {King, Heart}}
 	result := orderByRank(unordered, true)
-	if !cardsEqual(result, ordered) {
+	if !HandsEqual(result, ordered) {
 		t.Errorf("Expected: %v Actual: %v", ordered, result)
 	}
 }
@@ -100,7 +103,7 @@ func TestOrderByRankAceHigh(t *testing.T) {
 	unordered := []Card{{King, Heart}, {Queen, Heart}, {Jack, Heart}, {Ten, Heart}, {Nine, Heart}, {Ace, Heart}}
 	ordered := []Card{{Nine, Heart}, {Ten, Heart}, {Jack, Heart}, {Queen, Heart}, {King, Heart}, {Ace, Heart}}
 	result := orderByRank(unordered, false)
-	if !cardsEqual(result, ordered) {
+	if !HandsEqual(result, ordered) {
 		t.Errorf("Expected: %v Actual: %v", ordered, result)
 	}
 }
@@ -192,7 +195,7 @@ func TestStraightFlush(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		hasStraightFlush, _ := straightFlush(test.hand)
+		hasStraightFlush, _ := straightFlush(test.hand, AceHighOrLow)
 		if hasStraightFlush != test.hasStraightFlush {
 			t.Errorf("Expected straightFlush(%v) to return %v, but instead it returned %v.",
 				test.hand,
@@ -244,6 +247,10 @@ func TestFlush(t *testing.T) {
 			[]Card{{Two, Heart}, {Ace, Heart}, {Three, Heart}, {Four, Heart}, {Jack, Diamond}, {Five, Spade}, {Seven, Diamond}},
 			false,
 		},
+		{
+			[]Card{{Two, Heart}, {Ace, Heart}, {Three, Heart}, {Four, Heart}, {Jack, Heart}, {Seven, Heart}, {Seven, Diamond}},
+			true,
+		},
 		{
 			[]Card{},
 			false,
@@ -260,6 +267,39 @@ func TestFlush(t *testing.T) {
 	}
 }
 
+func TestFullHouse(t *testing.T) {
+	tests := []struct {
+		hand         []Card
+		hasFullHouse bool
+	}{
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Two, Heart}, {Two, Spade}},
+			true,
+		},
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Two, Heart}, {Two, Spade}, {Two, Club}, {Ace, Club}},
+			true,
+		},
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Two, Heart}, {Three, Spade}},
+			false,
+		},
+		{
+			[]Card{},
+			false,
+		},
+	}
+	for _, test := range tests {
+		hasFullHouse, _ := fullHouse(test.hand)
+		if hasFullHouse != test.hasFullHouse {
+			t.Errorf("Expected fullHouse(%v) to return %v, but instead it returned %v.",
+				test.hand,
+				test.hasFullHouse,
+				hasFullHouse)
+		}
+	}
+}
+
 func TestStraight(t *testing.T) {
 	tests := []struct {
 		hand        []Card
@@ -283,7 +323,7 @@ func TestStraight(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		hasStraight, _ := straight(test.hand)
+		hasStraight, _ := straight(test.hand, AceHighOrLow)
 		if hasStraight != test.hasStraight {
 			t.Errorf("Expected straight(%v) to return %v, but instead it returned %v.",
 				test.hand,
@@ -293,6 +333,69 @@ func TestStraight(t *testing.T) {
 	}
 }
 
+// Tests that an ace low straight is found in seven card hands where the other cards could interrupt
+// the consecutive count, such as pairs within the wheel and high cards that follow it.
+func TestStraightSevenCardWheel(t *testing.T) {
+	tests := [][]Card{
+		{{Ace, Club}, {Two, Diamond}, {Three, Club}, {Four, Spade}, {Five, Diamond}, {King, Heart}, {Queen, Spade}},
+		{{Ace, Club}, {Two, Diamond}, {Three, Club}, {Three, Spade}, {Four, Spade}, {Five, Diamond}, {King, Heart}},
+		{{Ace, Club}, {Ace, Diamond}, {Two, Club}, {Three, Spade}, {Four, Spade}, {Five, Diamond}, {Five, Heart}},
+		{{Five, Club}, {King, Diamond}, {Four, Club}, {Ace, Spade}, {Three, Spade}, {Two, Diamond}, {Seven, Heart}},
+		{{Ace, Club}, {Two, Diamond}, {Two, Club}, {Two, Spade}, {Three, Spade}, {Four, Diamond}, {Five, Heart}},
+	}
+	for _, hand := range tests {
+		hasStraight, _ := straight(hand, AceHighOrLow)
+		if !hasStraight {
+			t.Errorf("Expected straight(%v) to find an ace low straight, but it didn't.", hand)
+		}
+		if high := straightHighCard(hand, AceHighOrLow); high != Five {
+			t.Errorf("Expected the best straight in %v to be five high, but instead it was %v high.", hand, high)
+		}
+	}
+}
+
+func TestEvaluatorAceMode(t *testing.T) {
+	wheel := []Card{{Ace, Club}, {Two, Diamond}, {Three, Club}, {Four, Spade}, {Five, Diamond}}
+	broadway := []Card{{Ten, Club}, {Jack, Diamond}, {Queen, Club}, {King, Spade}, {Ace, Diamond}}
+	steelWheel := []Card{{Ace, Club}, {Two, Club}, {Three, Club}, {Four, Club}, {Five, Club}}
+	royal := []Card{{Ten, Club}, {Jack, Club}, {Queen, Club}, {King, Club}, {Ace, Club}}
+	tests := []struct {
+		aces     AceMode
+		hand     []Card
+		category HandCategory
+	}{
+		{AceHighOrLow, wheel, Straight},
+		{AceLowOnly, wheel, Straight},
+		{AceHighOnly, wheel, HighCard},
+		{AceHighOrLow, broadway, Straight},
+		{AceLowOnly, broadway, HighCard},
+		{AceHighOnly, broadway, Straight},
+		{AceHighOrLow, steelWheel, StraightFlush},
+		{AceHighOnly, steelWheel, Flush},
+		{AceLowOnly, royal, Flush},
+		{AceHighOnly, royal, StraightFlush},
+	}
+	for _, test := range tests {
+		category := Evaluator{Aces: test.aces}.Category(test.hand)
+		if category != test.category {
+			t.Errorf("Expected Category(%v) with ace mode %v to return %v, but instead it returned %v.",
+				test.hand,
+				test.aces,
+				test.category,
+				category)
+		}
+	}
+}
+
+// Tests that a straight using the ace low is still compared as five high when aces can only be low.
+func TestEvaluatorAceLowOnlyCompare(t *testing.T) {
+	wheel := []Card{{Ace, Club}, {Two, Diamond}, {Three, Club}, {Four, Spade}, {Five, Diamond}}
+	sixHigh := []Card{{Six, Club}, {Two, Diamond}, {Three, Club}, {Four, Spade}, {Five, Diamond}}
+	if result := (Evaluator{Aces: AceLowOnly}).CompareHands(wheel, sixHigh); result != -1 {
+		t.Errorf("Expected %v to lose to %v, but CompareHands returned %v.", wheel, sixHigh, result)
+	}
+}
+
 func TestThreeOfAKind(t *testing.T) {
 	tests := []struct {
 		hand            []Card
@@ -384,15 +487,555 @@ func TestPair(t *testing.T) {
 	}
 }
 
-func cardsEqual(a, b []Card) bool {
-	if len(a) != len(b) {
-		return false
+func TestAllCards(t *testing.T) {
+	all := AllCards()
+	if len(all) != 52 {
+		t.Errorf("Expected AllCards to return 52 cards but instead it returned %v.", len(all))
+	}
+	seen := make(map[Card]bool)
+	for _, c := range all {
+		if seen[c] {
+			t.Errorf("Expected AllCards to return distinct cards but %v appeared more than once.", c)
+		}
+		seen[c] = true
+	}
+	first, last := Card{Two, Spade}, Card{Ace, Diamond}
+	if all[0] != first || all[51] != last {
+		t.Errorf("Expected AllCards to start with %v and end with %v, but it started with %v and ended with %v.",
+			first, last, all[0], all[51])
+	}
+	if !HandsEqual(all, AllCards()) {
+		t.Errorf("Expected AllCards to return the same order every time.")
+	}
+}
+
+func TestDeckClone(t *testing.T) {
+	deck := GenerateDeck()
+	clone := deck.Clone()
+	clone.Draw()
+	if deck.Length() != 52 || clone.Length() != 51 {
+		t.Errorf("Expected drawing from a clone not to affect the original deck, but the original has %v cards and the clone has %v.",
+			deck.Length(),
+			clone.Length())
+	}
+}
+
+func TestDeckRemove(t *testing.T) {
+	deck := GenerateDeck()
+	if err := deck.Remove(Card{Ace, Heart}); err != nil {
+		t.Errorf("Expected removing a card from a full deck to succeed, but there was an error: %v", err)
+	}
+	if deck.Length() != 51 {
+		t.Errorf("Expected the deck to have 51 cards after removing one, but instead it had %v.", deck.Length())
+	}
+	if err := deck.Remove(Card{Ace, Heart}); err == nil {
+		t.Errorf("Expected an error removing a card that isn't in the deck, but there wasn't one.")
+	}
+}
+
+func TestDeckRemainingAndDealtCount(t *testing.T) {
+	deck := GenerateDeck()
+	if deck.Remaining() != 52 || deck.DealtCount() != 0 {
+		t.Errorf("Expected a new deck to have 52 cards remaining and none dealt, but instead it had %v and %v.", deck.Remaining(), deck.DealtCount())
+	}
+	for i := 0; i < 5; i++ {
+		deck.Draw()
+	}
+	deck.Remove(deck.GetCards()[0])
+	if deck.Remaining() != 46 || deck.DealtCount() != 6 || deck.Length() != deck.Remaining() {
+		t.Errorf("Expected 46 cards remaining and 6 dealt after drawing 5 and removing 1, but instead there were %v and %v.",
+			deck.Remaining(), deck.DealtCount())
+	}
+}
+
+func TestDeckCut(t *testing.T) {
+	deck := Deck{cards: []Card{{Two, Club}, {Three, Club}, {Four, Club}, {Five, Club}, {Six, Club}}}
+	if err := deck.Cut(2); err != nil {
+		t.Fatalf("Expected cutting the deck at position 2 to succeed, but there was an error: %v", err)
+	}
+	expected := []Card{{Four, Club}, {Five, Club}, {Six, Club}, {Two, Club}, {Three, Club}}
+	if !HandsEqual(deck.GetCards(), expected) {
+		t.Errorf("Expected the cut deck to be %v, but instead it was %v.", expected, deck.GetCards())
+	}
+	for _, position := range []int{-1, 0, 5, 6} {
+		if err := deck.Cut(position); err == nil {
+			t.Errorf("Expected an error cutting a deck of 5 cards at position %v, but there wasn't one.", position)
+		}
+	}
+	if !HandsEqual(deck.GetCards(), expected) {
+		t.Errorf("Expected invalid cuts not to change the deck, but instead it was %v.", deck.GetCards())
+	}
+}
+
+func TestGenerateDeckCommitted(t *testing.T) {
+	deck, commitment := GenerateDeckCommitted(42)
+	if deck.Length() != 52 {
+		t.Errorf("Expected the deck to have 52 cards but instead it had %v.", deck.Length())
+	}
+	sameDeck, sameCommitment := GenerateDeckCommitted(42)
+	if !HandsEqual(deck.GetCards(), sameDeck.GetCards()) || commitment != sameCommitment {
+		t.Errorf("Expected decks generated with the same seed to be identical.")
+	}
+	_, otherCommitment := GenerateDeckCommitted(43)
+	if commitment == otherCommitment {
+		t.Errorf("Expected decks generated with different seeds to have different commitments.")
+	}
+	if deck.RevealSeed() != 42 {
+		t.Errorf("Expected RevealSeed to return 42 but instead it returned %v.", deck.RevealSeed())
+	}
+}
+
+func TestVerifyCommitment(t *testing.T) {
+	deck, commitment := GenerateDeckCommitted(7)
+	deck.Draw()
+	if !VerifyCommitment(deck.RevealSeed(), commitment) {
+		t.Errorf("Expected commitment %v to be verified by seed %v.", commitment, deck.RevealSeed())
+	}
+	if VerifyCommitment(8, commitment) {
+		t.Errorf("Expected commitment %v not to be verified by seed 8.", commitment)
+	}
+}
+
+func TestCompareHands(t *testing.T) {
+	tests := []struct {
+		a        []Card
+		b        []Card
+		expected int
+	}{
+		// Full house beats a flush.
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Two, Heart}, {Two, Spade}},
+			[]Card{{Ace, Club}, {King, Club}, {Four, Club}, {Six, Club}, {Two, Club}},
+			1,
+		},
+		// Higher kicker wins when both players have the same pair.
+		{
+			[]Card{{King, Club}, {King, Heart}, {Nine, Diamond}, {Seven, Heart}, {Two, Spade}},
+			[]Card{{King, Spade}, {King, Diamond}, {Ten, Diamond}, {Seven, Club}, {Two, Club}},
+			-1,
+		},
+		// Ace low straight loses to a six high straight.
+		{
+			[]Card{{Ace, Club}, {Two, Heart}, {Three, Diamond}, {Four, Heart}, {Five, Spade}},
+			[]Card{{Two, Spade}, {Three, Club}, {Four, Diamond}, {Five, Club}, {Six, Club}},
+			-1,
+		},
+		// Only the best five cards are considered, so the sixth card does not break the tie.
+		{
+			[]Card{{Ace, Club}, {Ace, Heart}, {King, Diamond}, {Queen, Heart}, {Jack, Spade}, {Three, Club}},
+			[]Card{{Ace, Spade}, {Ace, Diamond}, {King, Club}, {Queen, Club}, {Jack, Club}, {Two, Heart}},
+			0,
+		},
+		// Higher full house wins when both players share trips.
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {King, Heart}, {King, Spade}, {Two, Club}},
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Queen, Heart}, {Queen, Spade}, {Two, Club}},
+			1,
+		},
+		// The three of a kind decides a full house before the pair.
+		{
+			[]Card{{King, Club}, {King, Heart}, {King, Diamond}, {Two, Heart}, {Two, Spade}},
+			[]Card{{Queen, Club}, {Queen, Heart}, {Queen, Diamond}, {Ace, Heart}, {Ace, Spade}},
+			1,
+		},
+	}
+	for _, test := range tests {
+		result := CompareHands(test.a, test.b)
+		if result != test.expected {
+			t.Errorf("Expected CompareHands(%v, %v) to return %v, but instead it returned %v.",
+				test.a,
+				test.b,
+				test.expected,
+				result)
+		}
+	}
+}
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		hand     []Card
+		category HandCategory
+	}{
+		{[]Card{{Ten, Heart}, {Jack, Heart}, {Queen, Heart}, {King, Heart}, {Ace, Heart}}, StraightFlush},
+		{[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Two, Heart}, {Two, Spade}}, FullHouse},
+		{[]Card{{Two, Diamond}, {Two, Club}, {Four, Spade}, {Five, Heart}, {Four, Club}}, TwoPair},
+		{[]Card{{Jack, Heart}, {King, Diamond}, {Ten, Spade}, {Nine, Heart}, {Three, Club}}, HighCard},
+		{[]Card{}, HighCard},
+	}
+	for _, test := range tests {
+		category := Category(test.hand)
+		if category != test.category {
+			t.Errorf("Expected Category(%v) to return %v, but instead it returned %v.", test.hand, test.category, category)
+		}
 	}
-	for i, cardA := range a {
-		cardB := b[i]
-		if cardA.rank != cardB.rank || cardA.suit != cardB.suit {
-			return false
+}
+
+func TestBestHand(t *testing.T) {
+	tests := []struct {
+		cards    []Card
+		expected Hand
+	}{
+		{
+			[]Card{{Two, Club}, {Seven, Heart}, {Ace, Spade}, {Ace, Heart}, {King, Diamond}, {Nine, Club}, {Four, Spade}},
+			Hand{{Seven, Heart}, {Ace, Spade}, {Ace, Heart}, {King, Diamond}, {Nine, Club}},
+		},
+		// The board plays, so neither hole card is used.
+		{
+			[]Card{{Two, Club}, {Three, Heart}, {Ten, Spade}, {Jack, Heart}, {Queen, Diamond}, {King, Club}, {Ace, Spade}},
+			Hand{{Ten, Spade}, {Jack, Heart}, {Queen, Diamond}, {King, Club}, {Ace, Spade}},
+		},
+		{
+			[]Card{{Two, Club}, {Three, Heart}},
+			Hand{{Two, Club}, {Three, Heart}},
+		},
+	}
+	for _, test := range tests {
+		best := BestHand(test.cards)
+		if !HandsEqual(best, test.expected) {
+			t.Errorf("Expected BestHand(%v) to return %v, but instead it returned %v.", test.cards, test.expected, best)
+		}
+	}
+}
+
+func TestCompareOnBoard(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []Card
+		b        []Card
+		board    []Card
+		expected int
+	}{
+		{
+			"shared trips broken by the first kicker",
+			[]Card{{Ace, Club}, {Queen, Heart}},
+			[]Card{{Ace, Spade}, {Jack, Heart}},
+			[]Card{{Seven, Club}, {Seven, Heart}, {Seven, Diamond}, {Four, Spade}, {Two, Club}},
+			1,
+		},
+		{
+			"shared trips broken by the second kicker",
+			[]Card{{King, Club}, {Three, Heart}},
+			[]Card{{King, Spade}, {Five, Heart}},
+			[]Card{{Seven, Club}, {Seven, Heart}, {Seven, Diamond}, {Four, Spade}, {Two, Club}},
+			-1,
+		},
+		{
+			"shared trips where the board plays the kickers",
+			[]Card{{Three, Club}, {Two, Heart}},
+			[]Card{{Four, Club}, {Two, Diamond}},
+			[]Card{{Seven, Club}, {Seven, Heart}, {Seven, Diamond}, {King, Spade}, {Queen, Club}},
+			0,
+		},
+		{
+			"trips made with a paired board broken by kickers",
+			[]Card{{Eight, Club}, {Ace, Heart}},
+			[]Card{{Eight, Spade}, {King, Heart}},
+			[]Card{{Eight, Heart}, {Eight, Diamond}, {Jack, Diamond}, {Four, Spade}, {Two, Club}},
+			1,
+		},
+		{
+			"quads on the board broken by the kicker",
+			[]Card{{Ace, Club}, {Two, Heart}},
+			[]Card{{Queen, Spade}, {Jack, Heart}},
+			[]Card{{King, Club}, {King, Heart}, {King, Diamond}, {King, Spade}, {Three, Club}},
+			1,
+		},
+		{
+			"quads over quads",
+			[]Card{{Nine, Club}, {Nine, Heart}},
+			[]Card{{Four, Spade}, {Four, Heart}},
+			[]Card{{Nine, Spade}, {Nine, Diamond}, {Four, Diamond}, {Four, Club}, {Ace, Club}},
+			1,
+		},
+		{
+			"full house on the board plays for both players",
+			[]Card{{Two, Club}, {Three, Heart}},
+			[]Card{{Four, Spade}, {Five, Heart}},
+			[]Card{{Nine, Spade}, {Nine, Diamond}, {Nine, Club}, {King, Club}, {King, Heart}},
+			0,
+		},
+		{
+			"full house on the board improved by a higher pair",
+			[]Card{{Ace, Club}, {Ace, Heart}},
+			[]Card{{Queen, Spade}, {Queen, Heart}},
+			[]Card{{Nine, Spade}, {Nine, Diamond}, {Nine, Club}, {King, Club}, {King, Heart}},
+			1,
+		},
+	}
+	for _, test := range tests {
+		result := CompareOnBoard(test.a, test.b, test.board)
+		if result != test.expected {
+			t.Errorf("%v: Expected CompareOnBoard(%v, %v, %v) to return %v, but instead it returned %v.",
+				test.name,
+				test.a,
+				test.b,
+				test.board,
+				test.expected,
+				result)
+		}
+	}
+}
+
+// Tests that trips made from a board pair and trips made with a pocket pair are compared by the rank
+// of the trips before the kickers.
+func TestCompareHandsTripsRankBeforeKickers(t *testing.T) {
+	boardPairTrips := []Card{{Eight, Club}, {Eight, Heart}, {Eight, Diamond}, {Ace, Spade}, {King, Club}}
+	set := []Card{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Three, Spade}, {Two, Club}}
+	if result := CompareHands(boardPairTrips, set); result != -1 {
+		t.Errorf("Expected %v to lose to %v, but CompareHands returned %v.", boardPairTrips, set, result)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		hand        Hand
+		description string
+	}{
+		{Hand{{Ten, Heart}, {Jack, Heart}, {Queen, Heart}, {King, Heart}, {Ace, Heart}}, "Royal Flush"},
+		{Hand{{Ace, Club}, {Two, Club}, {Three, Club}, {Four, Club}, {Five, Club}}, "Straight Flush, Ace to Five"},
+		{Hand{{Nine, Club}, {Nine, Heart}, {Nine, Diamond}, {Nine, Spade}, {Two, Spade}}, "Four of a Kind, Nines"},
+		{Hand{{King, Club}, {King, Heart}, {King, Diamond}, {Two, Heart}, {Two, Spade}}, "Full House, Kings full of Twos"},
+		{Hand{{Ace, Club}, {King, Club}, {Four, Club}, {Six, Club}, {Two, Club}}, "Flush, Ace high"},
+		{Hand{{Six, Club}, {Seven, Heart}, {Eight, Diamond}, {Nine, Heart}, {Ten, Spade}}, "Straight, Six to Ten"},
+		{Hand{{Seven, Club}, {Seven, Heart}, {Seven, Diamond}, {Ace, Heart}, {Two, Spade}}, "Three of a Kind, Sevens"},
+		{Hand{{King, Club}, {King, Heart}, {Two, Diamond}, {Two, Heart}, {Five, Spade}}, "Two Pair, Kings and Twos"},
+		{Hand{{Six, Club}, {Six, Heart}, {Two, Diamond}, {Nine, Heart}, {Five, Spade}}, "Pair, Sixes"},
+		{Hand{{Jack, Heart}, {King, Diamond}, {Ten, Spade}, {Nine, Heart}, {Three, Club}}, "High Card, King"},
+		{Hand{}, ""},
+	}
+	for _, test := range tests {
+		description := Describe(test.hand)
+		if description != test.description {
+			t.Errorf("Expected Describe(%v) to return %q, but instead it returned %q.", test.hand, test.description, description)
+		}
+	}
+}
+
+func TestCompareAndExplain(t *testing.T) {
+	tests := []struct {
+		a           Hand
+		b           Hand
+		result      int
+		explanation string
+	}{
+		{
+			Hand{{Ace, Club}, {King, Club}, {Four, Club}, {Six, Club}, {Two, Club}},
+			Hand{{Six, Club}, {Seven, Heart}, {Eight, Diamond}, {Nine, Heart}, {Ten, Spade}},
+			1,
+			"Hand A wins: Flush, Ace high beats Straight, Six to Ten",
+		},
+		{
+			Hand{{Six, Club}, {Six, Heart}, {Two, Diamond}, {Nine, Heart}, {Five, Spade}},
+			Hand{{Six, Diamond}, {Six, Spade}, {Two, Club}, {King, Heart}, {Five, Club}},
+			-1,
+			"Hand B wins: both have Pair, Sixes, but King beats Nine",
+		},
+		{
+			Hand{{King, Club}, {King, Heart}, {Two, Diamond}, {Two, Heart}, {Five, Spade}},
+			Hand{{King, Diamond}, {King, Spade}, {Two, Club}, {Two, Spade}, {Four, Spade}},
+			1,
+			"Hand A wins: both have Two Pair, Kings and Twos, but Five beats Four",
+		},
+		{
+			Hand{{Six, Club}, {Six, Heart}, {Two, Diamond}, {Nine, Heart}, {Five, Spade}},
+			Hand{{Six, Diamond}, {Six, Spade}, {Two, Club}, {Nine, Club}, {Five, Club}},
+			0,
+			"Tie: both have Pair, Sixes",
+		},
+	}
+	for _, test := range tests {
+		result, explanation := CompareAndExplain(test.a, test.b)
+		if result != test.result || explanation != test.explanation {
+			t.Errorf("Expected comparing %v and %v to return %v, %q, but instead it returned %v, %q.", test.a, test.b, test.result, test.explanation, result, explanation)
+		}
+	}
+}
+
+func TestCardEquals(t *testing.T) {
+	tests := []struct {
+		a        Card
+		b        Card
+		expected bool
+	}{
+		{Card{Ace, Spade}, Card{Ace, Spade}, true},
+		{Card{Ace, Spade}, Card{Ace, Heart}, false},
+		{Card{Ace, Spade}, Card{King, Spade}, false},
+	}
+	for _, test := range tests {
+		if test.a.Equals(test.b) != test.expected {
+			t.Errorf("Expected %v.Equals(%v) to return %v, but it didn't.", test.a, test.b, test.expected)
+		}
+	}
+}
+
+func TestHandsEqual(t *testing.T) {
+	hand := Hand{Card{Ace, Spade}, Card{King, Heart}}
+	tests := []struct {
+		other    Hand
+		expected bool
+	}{
+		{Hand{Card{Ace, Spade}, Card{King, Heart}}, true},
+		{Hand{Card{King, Heart}, Card{Ace, Spade}}, false},
+		{Hand{Card{Ace, Spade}}, false},
+		{Hand{}, false},
+	}
+	for _, test := range tests {
+		if HandsEqual(hand, test.other) != test.expected {
+			t.Errorf("Expected HandsEqual(%v, %v) to return %v, but it didn't.", hand, test.other, test.expected)
+		}
+	}
+}
+
+func TestHandString(t *testing.T) {
+	a := Hand{Card{Seven, Club}, Card{King, Diamond}, Card{Ace, Spade}, Card{Two, Spade}, Card{King, Heart}}
+	b := Hand{Card{King, Heart}, Card{Two, Spade}, Card{King, Diamond}, Card{Seven, Club}, Card{Ace, Spade}}
+	expected := "As Kh Kd 7c 2s"
+	if a.String() != expected || b.String() != expected {
+		t.Errorf("Expected both hands to be %q, but instead they were %q and %q.", expected, a.String(), b.String())
+	}
+	if a[0] != (Card{Seven, Club}) {
+		t.Errorf("Expected sorting not to modify the hand, but its first card became %v.", a[0])
+	}
+	if joker := (Hand{Joker, Card{Two, Club}}).String(); joker != "2c Joker" {
+		t.Errorf("Expected a joker to come last, but instead the hand was %q.", joker)
+	}
+}
+
+func TestScore(t *testing.T) {
+	hand := []Card{{Six, Spade}, {Six, Heart}, {King, Club}, {Nine, Diamond}, {Two, Spade}}
+	if score := Score(hand); score != 0x16D920 {
+		t.Errorf("Expected a pair of Sixes with King, Nine, Two kickers to score %X, but instead it scored %X.", 0x16D920, score)
+	}
+	if score := Score([]Card{}); score != 0 {
+		t.Errorf("Expected an empty hand to score 0, but instead it scored %v.", score)
+	}
+}
+
+// Tests that scores order hands from worst to best, including hands separated only by kickers.
+func TestScoreOrdering(t *testing.T) {
+	ordered := [][]Card{
+		{{Seven, Spade}, {Five, Heart}, {Four, Club}, {Three, Diamond}, {Two, Spade}},
+		{{King, Spade}, {Queen, Heart}, {Nine, Club}, {Four, Diamond}, {Two, Spade}},
+		{{King, Spade}, {Queen, Heart}, {Nine, Club}, {Four, Diamond}, {Three, Spade}},
+		{{Ace, Spade}, {Queen, Heart}, {Nine, Club}, {Four, Diamond}, {Two, Spade}},
+		{{Two, Spade}, {Two, Heart}, {Ace, Club}, {King, Diamond}, {Queen, Spade}},
+		{{Six, Spade}, {Six, Heart}, {King, Club}, {Nine, Diamond}, {Two, Spade}},
+		{{Six, Spade}, {Six, Heart}, {King, Club}, {Nine, Diamond}, {Three, Spade}},
+		{{Six, Spade}, {Six, Heart}, {Ace, Club}, {Three, Diamond}, {Two, Spade}},
+		{{Three, Spade}, {Three, Heart}, {Two, Club}, {Two, Diamond}, {Ace, Spade}},
+		{{King, Spade}, {King, Heart}, {Two, Club}, {Two, Diamond}, {Five, Spade}},
+		{{King, Spade}, {King, Heart}, {Three, Club}, {Three, Diamond}, {Two, Spade}},
+		{{Two, Spade}, {Two, Heart}, {Two, Club}, {Ace, Diamond}, {King, Spade}},
+		{{Ace, Spade}, {Two, Heart}, {Three, Club}, {Four, Diamond}, {Five, Spade}},
+		{{Two, Spade}, {Three, Heart}, {Four, Club}, {Five, Diamond}, {Six, Spade}},
+		{{Ten, Spade}, {Jack, Heart}, {Queen, Club}, {King, Diamond}, {Ace, Spade}},
+		{{Seven, Heart}, {Five, Heart}, {Four, Heart}, {Three, Heart}, {Two, Heart}},
+		{{Ace, Heart}, {King, Heart}, {Queen, Heart}, {Jack, Heart}, {Nine, Heart}},
+		{{Two, Spade}, {Two, Heart}, {Two, Club}, {Three, Diamond}, {Three, Spade}},
+		{{Two, Spade}, {Two, Heart}, {Two, Club}, {Ace, Diamond}, {Ace, Spade}},
+		{{Three, Spade}, {Three, Heart}, {Three, Club}, {Two, Diamond}, {Two, Spade}},
+		{{Two, Spade}, {Two, Heart}, {Two, Club}, {Two, Diamond}, {Three, Spade}},
+		{{Two, Spade}, {Two, Heart}, {Two, Club}, {Two, Diamond}, {Ace, Spade}},
+		{{Ace, Club}, {Two, Club}, {Three, Club}, {Four, Club}, {Five, Club}},
+		{{Nine, Club}, {Ten, Club}, {Jack, Club}, {Queen, Club}, {King, Club}},
+		{{Ten, Club}, {Jack, Club}, {Queen, Club}, {King, Club}, {Ace, Club}},
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, b := Score(ordered[i]), Score(ordered[j])
+			if (i < j && a >= b) || (i > j && a <= b) || (i == j && a != b) {
+				t.Errorf("Expected %v (score %X) and %v (score %X) to be ordered by their position, but they weren't.",
+					ordered[i], a, ordered[j], b)
+			}
+		}
+	}
+	// Hands that differ only by suit tie.
+	a := []Card{{Ace, Spade}, {Ace, Heart}, {Nine, Club}, {Four, Diamond}, {Two, Spade}}
+	b := []Card{{Ace, Club}, {Ace, Diamond}, {Nine, Heart}, {Four, Spade}, {Two, Heart}}
+	if Score(a) != Score(b) {
+		t.Errorf("Expected %v and %v to have the same score, but instead they scored %X and %X.", a, b, Score(a), Score(b))
+	}
+}
+
+// Tests that every evaluation entry point treats nil and empty cards as a hand with nothing in it.
+func TestEvaluateEmptyHands(t *testing.T) {
+	ace := []Card{{Ace, Spade}}
+	for _, empty := range [][]Card{nil, {}} {
+		tests := []struct {
+			name     string
+			actual   interface{}
+			expected interface{}
+		}{
+			{"getHandRank", getHandRank(empty, AceHighOrLow), highCardRank},
+			{"Category", Category(empty), HighCard},
+			{"Evaluator.Category", Evaluator{Aces: AceLowOnly}.Category(empty), HighCard},
+			{"Score", Score(empty), 0},
+			{"Evaluator.Score", Evaluator{Aces: AceHighOnly}.Score(empty), 0},
+			{"CompareHands with both empty", CompareHands(empty, empty), 0},
+			{"CompareHands against a card", CompareHands(empty, ace), -1},
+			{"Evaluator.CompareHands", Evaluator{}.CompareHands(ace, empty), 1},
+			{"CompareOnBoard", CompareOnBoard(empty, empty, empty), 0},
+			{"BestHand", len(BestHand(empty)), 0},
+			{"Describe", Describe(empty), ""},
+			{"QualifiesForLow", QualifiesForLow(empty), false},
+			{"CompareLowHands", CompareLowHands(empty, empty), 0},
+		}
+		for _, test := range tests {
+			if test.actual != test.expected {
+				t.Errorf("Expected %v of %#v to return %v, but instead it returned %v.", test.name, empty, test.expected, test.actual)
+			}
+		}
+		if _, err := EvaluateBestOfSeven(empty); err == nil {
+			t.Errorf("Expected EvaluateBestOfSeven of %#v to return an error, but it didn't.", empty)
+		}
+	}
+}
+
+// Tests that when an ace low straight and a higher straight share cards, the higher straight is the
+// one evaluated and returned, regardless of the order of the cards.
+func TestBestStraightWithAceLow(t *testing.T) {
+	tests := [][]Card{
+		{{Ace, Spade}, {Two, Heart}, {Three, Club}, {Four, Diamond}, {Five, Spade}, {Six, Heart}, {Seven, Club}},
+		{{Seven, Club}, {Six, Heart}, {Five, Spade}, {Four, Diamond}, {Three, Club}, {Two, Heart}, {Ace, Spade}},
+		{{Four, Diamond}, {Ace, Spade}, {Seven, Club}, {Two, Heart}, {Six, Heart}, {Three, Club}, {Five, Spade}},
+	}
+	expected := Hand{{Three, Club}, {Four, Diamond}, {Five, Spade}, {Six, Heart}, {Seven, Club}}
+	for _, cards := range tests {
+		result, err := EvaluateBestOfSeven(cards)
+		if err != nil {
+			t.Fatalf("Expected EvaluateBestOfSeven(%v) not to return an error, but it returned %v.", cards, err)
+		}
+		if result.Category != Straight || result.Description != "Straight, Three to Seven" {
+			t.Errorf("Expected %v to make a Three to Seven straight, but instead it made %q.", cards, result.Description)
+		}
+		best := BestHand(cards)
+		sort.Sort(best)
+		if !HandsEqual(best, expected) {
+			t.Errorf("Expected the best hand from %v to be %v, but instead it was %v.", cards, expected, best)
+		}
+	}
+	// The wheel is still a straight when it's the only one available.
+	wheel := []Card{{Ace, Spade}, {Two, Heart}, {Three, Club}, {Four, Diamond}, {Five, Spade}, {King, Heart}, {Nine, Club}}
+	if description := Describe(BestHand(wheel)); description != "Straight, Ace to Five" {
+		t.Errorf("Expected %v to make a wheel, but instead it made %q.", wheel, description)
+	}
+}
+
+func TestSuitColorAndSymbol(t *testing.T) {
+	tests := []struct {
+		suit   Suit
+		color  string
+		symbol rune
+	}{
+		{Spade, "black", '♠'},
+		{Club, "black", '♣'},
+		{Heart, "red", '♥'},
+		{Diamond, "red", '♦'},
+	}
+	for _, test := range tests {
+		if test.suit.Color() != test.color || test.suit.Symbol() != test.symbol {
+			t.Errorf("Expected %v to be %v with symbol %c, but instead it was %v with symbol %c.",
+				test.suit, test.color, test.symbol, test.suit.Color(), test.suit.Symbol())
 		}
 	}
-	return true
 }
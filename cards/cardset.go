@@ -0,0 +1,65 @@
+package cards
+
+import "math/bits"
+
+// CardSet is a set of the 52 playing cards, where each bit represents one card in the order of
+// AllCards. It makes checking whether a card is in a set a single operation, unlike searching a slice.
+// The zero value is an empty set.
+type CardSet uint64
+
+// NewCardSet returns a set of the cards. Cards that aren't one of the 52 playing cards are ignored.
+func NewCardSet(cards []Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s.Add(c)
+	}
+	return s
+}
+
+// Add adds the card to the set. Cards that aren't one of the 52 playing cards are ignored.
+func (s *CardSet) Add(c Card) {
+	if idx := cardIndex(c); idx != -1 {
+		*s |= 1 << idx
+	}
+}
+
+// Remove removes the card from the set.
+func (s *CardSet) Remove(c Card) {
+	if idx := cardIndex(c); idx != -1 {
+		*s &^= 1 << idx
+	}
+}
+
+// Contains returns true if the card is in the set.
+func (s CardSet) Contains(c Card) bool {
+	idx := cardIndex(c)
+	return idx != -1 && s&(1<<idx) != 0
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Cards returns the cards in the set, in the order of AllCards.
+func (s CardSet) Cards() []Card {
+	cards := make([]Card, 0, s.Count())
+	for remaining := uint64(s); remaining != 0; remaining &= remaining - 1 {
+		idx := bits.TrailingZeros64(remaining)
+		cards = append(cards, Card{Two + Rank(idx%13), suits[idx/13]})
+	}
+	return cards
+}
+
+// cardIndex returns the position of the card in AllCards, or -1 if it isn't a valid card.
+func cardIndex(c Card) int {
+	if c.rank < Two || c.rank > Ace {
+		return -1
+	}
+	for i, s := range suits {
+		if c.suit == s {
+			return i*13 + int(c.rank-Two)
+		}
+	}
+	return -1
+}
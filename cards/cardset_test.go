@@ -0,0 +1,47 @@
+package cards
+
+import "testing"
+
+func TestCardSetRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []Card
+	}{
+		{"empty", []Card{}},
+		{"one card", []Card{{Ace, Diamond}}},
+		{"in AllCards order", []Card{{Two, Spade}, {Ace, Spade}, {Ten, Club}, {King, Heart}, {Two, Diamond}}},
+		{"every card", AllCards()},
+	}
+	for _, test := range tests {
+		set := NewCardSet(test.cards)
+		if set.Count() != len(test.cards) {
+			t.Errorf("%v: Expected the set to have %v cards, but instead it had %v.", test.name, len(test.cards), set.Count())
+		}
+		if got := set.Cards(); !HandsEqual(got, test.cards) {
+			t.Errorf("%v: Expected the set's cards to be %v, but instead they were %v.", test.name, test.cards, got)
+		}
+		if NewCardSet(set.Cards()) != set {
+			t.Errorf("%v: Expected a set made from the set's cards to equal the set, but it didn't.", test.name)
+		}
+	}
+}
+
+func TestCardSetAddRemoveContains(t *testing.T) {
+	var set CardSet
+	aceOfSpades, twoOfClubs := Card{Ace, Spade}, Card{Two, Club}
+	set.Add(aceOfSpades)
+	set.Add(aceOfSpades)
+	set.Add(Card{})
+	if !set.Contains(aceOfSpades) || set.Contains(twoOfClubs) || set.Count() != 1 {
+		t.Errorf("Expected the set to contain only the Ace of Spades, but instead it contained %v.", set.Cards())
+	}
+	set.Add(twoOfClubs)
+	set.Remove(aceOfSpades)
+	set.Remove(aceOfSpades)
+	if set.Contains(aceOfSpades) || !set.Contains(twoOfClubs) || set.Count() != 1 {
+		t.Errorf("Expected the set to contain only the Two of Clubs, but instead it contained %v.", set.Cards())
+	}
+	if set.Contains(Card{}) {
+		t.Errorf("Expected the set not to contain an invalid card, but it did.")
+	}
+}
@@ -0,0 +1,34 @@
+package cards
+
+// Combinations returns every way of choosing k of the cards, ignoring order, with the cards of each
+// combination in the order they appear in cards. There is one empty combination when k is 0, and
+// none when k is negative or larger than the number of cards.
+func Combinations(cards []Card, k int) [][]Card {
+	combos := [][]Card{}
+	ForEachCombination(cards, k, func(combo []Card) {
+		combos = append(combos, append([]Card{}, combo...))
+	})
+	return combos
+}
+
+// ForEachCombination calls fn with every way of choosing k of the cards, in the same order as
+// Combinations. To avoid allocating, fn is passed the same slice each time, so it must copy the
+// combination if it keeps it after returning.
+func ForEachCombination(cards []Card, k int, fn func(combo []Card)) {
+	if k < 0 || k > len(cards) {
+		return
+	}
+	combo := make([]Card, k)
+	var search func(start int, size int)
+	search = func(start int, size int) {
+		if size == k {
+			fn(combo)
+			return
+		}
+		for i := start; i <= len(cards)-(k-size); i++ {
+			combo[size] = cards[i]
+			search(i+1, size+1)
+		}
+	}
+	search(0, 0)
+}
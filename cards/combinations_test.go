@@ -0,0 +1,53 @@
+package cards
+
+import "testing"
+
+func TestCombinations(t *testing.T) {
+	seven := AllCards()[:7]
+	tests := []struct {
+		name     string
+		cards    []Card
+		k        int
+		expected int
+	}{
+		{"C(7,5)", seven, 5, 21},
+		{"C(7,7)", seven, 7, 1},
+		{"C(7,1)", seven, 1, 7},
+		{"C(7,0)", seven, 0, 1},
+		{"C(52,2)", AllCards(), 2, 1326},
+		{"k larger than the cards", seven, 8, 0},
+		{"negative k", seven, -1, 0},
+		{"no cards", []Card{}, 2, 0},
+	}
+	for _, test := range tests {
+		combos := Combinations(test.cards, test.k)
+		if len(combos) != test.expected {
+			t.Errorf("%v: Expected %v combinations, but instead there were %v.", test.name, test.expected, len(combos))
+		}
+		seen := make(map[CardSet]bool)
+		for _, combo := range combos {
+			set := NewCardSet(combo)
+			if len(combo) != test.k || set.Count() != test.k {
+				t.Errorf("%v: Expected every combination to have %v distinct cards, but %v didn't.", test.name, test.k, combo)
+			}
+			if seen[set] {
+				t.Errorf("%v: Expected every combination to be different, but %v appeared twice.", test.name, combo)
+			}
+			seen[set] = true
+		}
+	}
+}
+
+func TestForEachCombination(t *testing.T) {
+	cards := AllCards()[:4]
+	calls := 0
+	ForEachCombination(cards, 2, func(combo []Card) {
+		if expected := Combinations(cards, 2)[calls]; !HandsEqual(combo, expected) {
+			t.Errorf("Expected combination %v to be %v, but instead it was %v.", calls, expected, combo)
+		}
+		calls++
+	})
+	if calls != 6 {
+		t.Errorf("Expected 6 combinations of 4 cards choose 2, but instead there were %v.", calls)
+	}
+}
@@ -0,0 +1,25 @@
+package cards
+
+import "fmt"
+
+// maxHoldemPlayers is the most players that can be dealt two hole cards each with five cards left for the board.
+const maxHoldemPlayers = (52 - 5) / 2
+
+// DealHoldem shuffles a deck and deals two hole cards to each player and a full five card board from it,
+// so no card is dealt twice. It is useful for equity experiments and test fixtures that don't need a game.
+func DealHoldem(numPlayers int) (holes [][2]Card, board [5]Card, err error) {
+	if numPlayers < 1 || numPlayers > maxHoldemPlayers {
+		return nil, board, fmt.Errorf("Can't deal Hold'em to %v players, there must be between 1 and %v.", numPlayers, maxHoldemPlayers)
+	}
+	deck := GenerateDeck()
+	holes = make([][2]Card, numPlayers)
+	for i := range holes {
+		for j := range holes[i] {
+			holes[i][j], _ = deck.Draw()
+		}
+	}
+	for i := range board {
+		board[i], _ = deck.Draw()
+	}
+	return holes, board, nil
+}
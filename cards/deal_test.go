@@ -0,0 +1,34 @@
+package cards
+
+import "testing"
+
+func TestDealHoldem(t *testing.T) {
+	for _, numPlayers := range []int{1, 9, maxHoldemPlayers} {
+		holes, board, err := DealHoldem(numPlayers)
+		if err != nil {
+			t.Fatalf("Expected DealHoldem(%v) not to return an error, but it returned %v.", numPlayers, err)
+		}
+		if len(holes) != numPlayers {
+			t.Errorf("Expected DealHoldem(%v) to deal hole cards to %v players, but instead it dealt to %v.", numPlayers, numPlayers, len(holes))
+		}
+		seen := make(map[Card]bool)
+		dealt := board[:]
+		for _, hole := range holes {
+			dealt = append(dealt, hole[0], hole[1])
+		}
+		for _, c := range dealt {
+			if c == (Card{}) || seen[c] {
+				t.Errorf("Expected DealHoldem(%v) to deal distinct cards, but %v was dealt twice or was empty.", numPlayers, c)
+			}
+			seen[c] = true
+		}
+	}
+}
+
+func TestDealHoldemInvalidPlayers(t *testing.T) {
+	for _, numPlayers := range []int{0, -1, maxHoldemPlayers + 1} {
+		if _, _, err := DealHoldem(numPlayers); err == nil {
+			t.Errorf("Expected DealHoldem(%v) to return an error, but it didn't.", numPlayers)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package cards
+
+import "testing"
+
+// FuzzEvaluateHand evaluates hands of five to seven distinct cards built from the fuzzed bytes and checks
+// that the category agrees with referenceCategory and that comparing the hands is consistent.
+func FuzzEvaluateHand(f *testing.F) {
+	// each byte picks a card of AllCards, a seven card straight with a duplicate rank is seeded since
+	// duplicates have broken straight detection before
+	f.Add([]byte{3, 4, 5, 5, 18, 6, 7, 0, 1, 2, 3, 4, 13, 26, 39, 12, 25, 38, 11, 10})
+	f.Add([]byte{12, 0, 1, 2, 3, 21, 34, 8, 9, 10, 11, 12, 47, 48, 49, 50, 51})
+	f.Add([]byte{0, 13, 26, 39, 1, 14, 27, 2, 15, 28, 3, 16, 29, 4, 17, 30})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		hands := fuzzHands(data)
+		for _, hand := range hands {
+			if category, expected := Category(hand), referenceCategory(hand); category != expected {
+				t.Fatalf("Expected %v to be a %v, but instead it was a %v.", hand, expected, category)
+			}
+			if CompareHands(hand, hand) != 0 {
+				t.Fatalf("Expected %v to tie with itself, but it didn't.", hand)
+			}
+		}
+		if len(hands) < 3 {
+			return
+		}
+		a, b, c := hands[0], hands[1], hands[2]
+		if CompareHands(a, b) != -CompareHands(b, a) {
+			t.Fatalf("Expected comparing %v and %v to be antisymmetric, but it wasn't.", a, b)
+		}
+		if CompareHands(a, b) >= 0 && CompareHands(b, c) >= 0 && CompareHands(a, c) < 0 {
+			t.Fatalf("Expected %v to beat or tie %v since it beats or ties %v, which beats or ties it, but it lost.", a, c, b)
+		}
+		if CompareHands(a, b) <= 0 && CompareHands(b, c) <= 0 && CompareHands(a, c) > 0 {
+			t.Fatalf("Expected %v to lose to or tie %v since it loses to or ties %v, which loses to or ties it, but it won.", a, c, b)
+		}
+	})
+}
+
+// Splits the bytes into up to three hands. The first byte of each hand sets whether it has five, six,
+// or seven cards, and each following byte picks a card of AllCards, skipping cards already in the hand.
+// Bytes left over that can't make a full hand are ignored.
+func fuzzHands(data []byte) [][]Card {
+	all := AllCards()
+	hands := [][]Card{}
+	for len(data) > 0 && len(hands) < 3 {
+		size := 5 + int(data[0])%3
+		data = data[1:]
+		hand := []Card{}
+		var set CardSet
+		for len(data) > 0 && len(hand) < size {
+			c := all[int(data[0])%len(all)]
+			data = data[1:]
+			if !set.Contains(c) {
+				set.Add(c)
+				hand = append(hand, c)
+			}
+		}
+		if len(hand) < size {
+			break
+		}
+		hands = append(hands, hand)
+	}
+	return hands
+}
+
+// referenceCategory is a simple implementation of Category, independent of the evaluator, that counts
+// ranks and suits and finds straights with bitmasks of ranks.
+func referenceCategory(hand []Card) HandCategory {
+	rankCounts := make(map[Rank]int)
+	suitMasks := make(map[Suit]int)
+	ranksMask := 0
+	for _, c := range hand {
+		rankCounts[c.Rank()]++
+		suitMasks[c.Suit()] |= 1 << c.Rank()
+		ranksMask |= 1 << c.Rank()
+	}
+	for _, mask := range suitMasks {
+		if hasStraightMask(mask) {
+			return StraightFlush
+		}
+	}
+	pairs, trips, quads := 0, 0, 0
+	for _, count := range rankCounts {
+		switch {
+		case count >= 4:
+			quads++
+		case count == 3:
+			trips++
+		case count == 2:
+			pairs++
+		}
+	}
+	flush := false
+	for _, mask := range suitMasks {
+		if countBits(mask) >= 5 {
+			flush = true
+		}
+	}
+	switch {
+	case quads > 0:
+		return FourOfAKind
+	case trips > 0 && (trips > 1 || pairs > 0):
+		return FullHouse
+	case flush:
+		return Flush
+	case hasStraightMask(ranksMask):
+		return Straight
+	case trips > 0:
+		return ThreeOfAKind
+	case pairs > 1:
+		return TwoPair
+	case pairs == 1:
+		return Pair
+	}
+	return HighCard
+}
+
+// Returns true if the mask, with a bit set for each rank, has five ranks in a row. An ace also counts
+// as the rank below two for the wheel.
+func hasStraightMask(mask int) bool {
+	if mask&(1<<Ace) != 0 {
+		mask |= 1 << 1
+	}
+	five := 0x1f
+	for low := 1; low <= int(Ten); low++ {
+		if mask&(five<<low) == five<<low {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the number of bits set in the mask.
+func countBits(mask int) int {
+	count := 0
+	for ; mask != 0; mask &= mask - 1 {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,76 @@
+package cards
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// JokerSuit is the suit of a joker, which has no rank.
+const JokerSuit Suit = "Joker"
+
+// MaxJokers is the most jokers a deck can include.
+const MaxJokers = 2
+
+// Joker is a wild card. The evaluator treats it as whichever card not already in the hand makes the
+// best hand. Both jokers in a deck are equal to Joker.
+var Joker = Card{0, JokerSuit}
+
+// IsJoker returns true if the card is a joker.
+func (c Card) IsJoker() bool {
+	return c.suit == JokerSuit
+}
+
+// GenerateDeckWithJokers returns a Deck of the 52 playing cards plus the specified number of jokers,
+// shuffled together with a source seeded from the current time. It returns an error if there are more
+// than MaxJokers jokers.
+func GenerateDeckWithJokers(jokers int) (Deck, error) {
+	if jokers < 0 || jokers > MaxJokers {
+		return Deck{}, fmt.Errorf("a deck can have between 0 and %v jokers, not %v", MaxJokers, jokers)
+	}
+	allCards := AllCards()
+	for i := 0; i < jokers; i++ {
+		allCards = append(allCards, Joker)
+	}
+	return Deck{cards: shuffle(allCards, rand.New(NewTimeSource()).Intn), jokers: jokers}, nil
+}
+
+// hasJoker returns true if any of the cards is a joker.
+func hasJoker(cards []Card) bool {
+	for _, c := range cards {
+		if c.IsJoker() {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceJokers returns the cards with each joker replaced by the card not already among them that
+// makes the best hand. Cards without a joker are returned unchanged.
+func replaceJokers(cards []Card, aces AceMode) []Card {
+	jokerIdx := -1
+	for i, c := range cards {
+		if c.IsJoker() {
+			jokerIdx = i
+			break
+		}
+	}
+	if jokerIdx == -1 {
+		return cards
+	}
+	inHand := NewCardSet(cards)
+	var best []Card
+	bestScore := -1
+	for _, c := range AllCards() {
+		if inHand.Contains(c) {
+			continue
+		}
+		candidate := append([]Card{}, cards...)
+		candidate[jokerIdx] = c
+		// any other jokers are replaced given this choice for the first
+		candidate = replaceJokers(candidate, aces)
+		if score := evaluate(candidate, aces).score(); score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,64 @@
+package cards
+
+import "testing"
+
+func TestJokerEvaluation(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand     []Card
+		category HandCategory
+		sameAs   []Card // the hand the joker should be played as
+	}{
+		{
+			"joker completes a flush",
+			[]Card{{Ace, Spade}, {King, Spade}, {Seven, Spade}, {Two, Spade}, Joker, {Nine, Diamond}, {Three, Club}},
+			Flush,
+			[]Card{{Ace, Spade}, {King, Spade}, {Queen, Spade}, {Seven, Spade}, {Two, Spade}},
+		},
+		{
+			"joker completes a straight",
+			[]Card{{Five, Heart}, {Six, Club}, {Seven, Diamond}, {Eight, Spade}, Joker, {King, Club}, {Two, Diamond}},
+			Straight,
+			[]Card{{Five, Heart}, {Six, Club}, {Seven, Diamond}, {Eight, Spade}, {Nine, Club}},
+		},
+		{
+			"two jokers make a royal flush",
+			[]Card{{Ten, Heart}, {Jack, Heart}, {Queen, Heart}, Joker, Joker},
+			StraightFlush,
+			[]Card{{Ten, Heart}, {Jack, Heart}, {Queen, Heart}, {King, Heart}, {Ace, Heart}},
+		},
+	}
+	for _, test := range tests {
+		if category := Category(test.hand); category != test.category {
+			t.Errorf("%v: Expected the category to be %v, but instead it was %v.", test.name, test.category, category)
+		}
+		if score, expected := Score(test.hand), Score(test.sameAs); score != expected {
+			t.Errorf("%v: Expected the hand to score the same as %v (%#x), but instead it scored %#x.", test.name, test.sameAs, expected, score)
+		}
+	}
+}
+
+func TestGenerateDeckWithJokers(t *testing.T) {
+	for jokers := 0; jokers <= MaxJokers; jokers++ {
+		deck, err := GenerateDeckWithJokers(jokers)
+		if err != nil {
+			t.Fatalf("Expected a deck with %v jokers not to return an error, but it returned %v.", jokers, err)
+		}
+		found := 0
+		for _, c := range deck.GetCards() {
+			if c.IsJoker() {
+				found++
+			}
+		}
+		if deck.Length() != 52+jokers || found != jokers {
+			t.Errorf("Expected a deck of %v cards with %v jokers, but instead it had %v cards and %v jokers.", 52+jokers, jokers, deck.Length(), found)
+		}
+		deck.Draw()
+		if deck.DealtCount() != 1 {
+			t.Errorf("Expected 1 card to be dealt from a deck with %v jokers, but instead %v were.", jokers, deck.DealtCount())
+		}
+	}
+	if _, err := GenerateDeckWithJokers(MaxJokers + 1); err == nil {
+		t.Errorf("Expected an error generating a deck with %v jokers, but there wasn't one.", MaxJokers+1)
+	}
+}
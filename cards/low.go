@@ -0,0 +1,56 @@
+package cards
+
+// Highest rank that can be part of an eight-or-better low hand.
+const lowQualifier = 8
+
+// lowRanks returns the ranks of the best eight-or-better low hand that can be made from the cards,
+// ordered from highest to lowest with aces counted as one, and whether a qualifying low hand exists.
+// Straights and flushes do not count against a low hand, but pairs do, so a low hand needs five
+// distinct ranks of eight or lower.
+func lowRanks(hand []Card) ([]int, bool) {
+	var present [lowQualifier + 1]bool
+	for _, c := range hand {
+		if c.rank == Ace {
+			present[1] = true
+		} else if c.rank <= lowQualifier {
+			present[c.rank] = true
+		}
+	}
+	ranks := []int{}
+	for r := 1; r <= lowQualifier && len(ranks) < 5; r++ {
+		if present[r] {
+			ranks = append([]int{r}, ranks...)
+		}
+	}
+	return ranks, len(ranks) == 5
+}
+
+// QualifiesForLow returns true if an eight-or-better low hand can be made from the cards.
+func QualifiesForLow(hand []Card) bool {
+	_, qualifies := lowRanks(hand)
+	return qualifies
+}
+
+// CompareLowHands compares the best eight-or-better low hands that can be made from a and b.
+// It returns 1 if a has the better (lower) low hand, -1 if b does, and 0 if they tie or neither qualifies.
+// A qualifying low hand always beats a hand that doesn't qualify.
+func CompareLowHands(a, b []Card) int {
+	aRanks, aQualifies := lowRanks(a)
+	bRanks, bQualifies := lowRanks(b)
+	if !aQualifies || !bQualifies {
+		if aQualifies {
+			return 1
+		} else if bQualifies {
+			return -1
+		}
+		return 0
+	}
+	for i := range aRanks {
+		if aRanks[i] < bRanks[i] {
+			return 1
+		} else if aRanks[i] > bRanks[i] {
+			return -1
+		}
+	}
+	return 0
+}
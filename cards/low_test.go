@@ -0,0 +1,63 @@
+package cards
+
+import "testing"
+
+func TestQualifiesForLow(t *testing.T) {
+	tests := []struct {
+		hand      []Card
+		qualifies bool
+	}{
+		{[]Card{{Ace, Club}, {Two, Heart}, {Three, Diamond}, {Four, Heart}, {Five, Spade}}, true},
+		{[]Card{{Eight, Club}, {Seven, Heart}, {Six, Diamond}, {Four, Heart}, {Two, Spade}, {King, Club}, {King, Heart}}, true},
+		{[]Card{{Nine, Club}, {Seven, Heart}, {Six, Diamond}, {Four, Heart}, {Two, Spade}}, false},
+		{[]Card{{Two, Club}, {Two, Heart}, {Three, Diamond}, {Four, Heart}, {Five, Spade}}, false},
+		{[]Card{}, false},
+	}
+	for _, test := range tests {
+		qualifies := QualifiesForLow(test.hand)
+		if qualifies != test.qualifies {
+			t.Errorf("Expected QualifiesForLow(%v) to return %v, but instead it returned %v.", test.hand, test.qualifies, qualifies)
+		}
+	}
+}
+
+func TestCompareLowHands(t *testing.T) {
+	tests := []struct {
+		a        []Card
+		b        []Card
+		expected int
+	}{
+		// The wheel is the best possible low.
+		{
+			[]Card{{Ace, Club}, {Two, Heart}, {Three, Diamond}, {Four, Heart}, {Five, Spade}},
+			[]Card{{Ace, Spade}, {Two, Club}, {Three, Club}, {Four, Diamond}, {Six, Spade}},
+			1,
+		},
+		// Lows are compared from the highest card down.
+		{
+			[]Card{{Eight, Club}, {Five, Heart}, {Four, Diamond}, {Three, Heart}, {Two, Spade}},
+			[]Card{{Seven, Spade}, {Six, Club}, {Five, Club}, {Four, Spade}, {Three, Spade}},
+			-1,
+		},
+		{
+			[]Card{{King, Club}, {Five, Heart}, {Four, Diamond}, {Three, Heart}, {Two, Spade}},
+			[]Card{{Seven, Spade}, {Six, Club}, {Five, Club}, {Four, Spade}, {Three, Spade}},
+			-1,
+		},
+		{
+			[]Card{{King, Club}, {Five, Heart}, {Four, Diamond}, {Three, Heart}, {Two, Spade}},
+			[]Card{{King, Spade}, {Six, Club}, {Five, Club}, {Four, Spade}, {Three, Spade}},
+			0,
+		},
+	}
+	for _, test := range tests {
+		result := CompareLowHands(test.a, test.b)
+		if result != test.expected {
+			t.Errorf("Expected CompareLowHands(%v, %v) to return %v, but instead it returned %v.",
+				test.a,
+				test.b,
+				test.expected,
+				result)
+		}
+	}
+}
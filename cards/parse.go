@@ -0,0 +1,103 @@
+package cards
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var rankSymbols = map[string]Rank{
+	"2": Two,
+	"3": Three,
+	"4": Four,
+	"5": Five,
+	"6": Six,
+	"7": Seven,
+	"8": Eight,
+	"9": Nine,
+	"T": Ten,
+	"J": Jack,
+	"Q": Queen,
+	"K": King,
+	"A": Ace,
+}
+
+var suitSymbols = map[string]Suit{
+	"s": Spade,
+	"c": Club,
+	"h": Heart,
+	"d": Diamond,
+}
+
+// Returns the card in the notation of ParseCard, with a question mark for an unknown rank or suit.
+// A joker is written as "Joker".
+func formatCard(c Card) string {
+	if c.IsJoker() {
+		return "Joker"
+	}
+	suit := "?"
+	for symbol, st := range suitSymbols {
+		if st == c.suit {
+			suit = symbol
+		}
+	}
+	return rankSymbol(c.rank) + suit
+}
+
+// Returns the symbol of the rank in the notation of ParseRank, or a question mark for an unknown rank.
+func rankSymbol(r Rank) string {
+	for symbol, rank := range rankSymbols {
+		if rank == r {
+			return symbol
+		}
+	}
+	return "?"
+}
+
+// ParseRank parses a rank from its single character symbol: 2-9, T, J, Q, K, or A.
+func ParseRank(s string) (Rank, error) {
+	rank, ok := rankSymbols[s]
+	if !ok {
+		return 0, fmt.Errorf("invalid rank %q", s)
+	}
+	return rank, nil
+}
+
+// ParseCard parses a card from its rank symbol followed by its suit symbol (s, c, h, or d).
+// Ex. "Ah" is the Ace of Hearts and "Td" is the Ten of Diamonds.
+func ParseCard(s string) (Card, error) {
+	if len(s) != 2 {
+		return Card{}, fmt.Errorf("invalid card %q, expected a rank followed by a suit", s)
+	}
+	rank, err := ParseRank(s[:1])
+	if err != nil {
+		return Card{}, fmt.Errorf("invalid card %q: %v", s, err)
+	}
+	suit, ok := suitSymbols[s[1:]]
+	if !ok {
+		return Card{}, fmt.Errorf("invalid card %q: invalid suit %q", s, s[1:])
+	}
+	return Card{rank, suit}, nil
+}
+
+// ParseCards parses a list of cards in the notation of ParseCard, separated by spaces, commas, or both.
+// Ex. "Ah Kd 7c" or "Ah,Kd, 7c". It returns an error if a card is invalid or appears more than once.
+func ParseCards(s string) ([]Card, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	parsed := []Card{}
+	var seen CardSet
+	for _, field := range fields {
+		card, err := ParseCard(field)
+		if err != nil {
+			return nil, err
+		}
+		if seen.Contains(card) {
+			return nil, fmt.Errorf("card %q appears more than once", field)
+		}
+		seen.Add(card)
+		parsed = append(parsed, card)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,65 @@
+package cards
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected Card
+	}{
+		{"Ah", Card{Ace, Heart}},
+		{"Td", Card{Ten, Diamond}},
+		{"2c", Card{Two, Club}},
+		{"Ks", Card{King, Spade}},
+	}
+	for _, test := range tests {
+		card, err := ParseCard(test.s)
+		if err != nil {
+			t.Errorf("Expected ParseCard(%q) not to return an error, but it returned %v.", test.s, err)
+		}
+		if card != test.expected {
+			t.Errorf("Expected ParseCard(%q) to return %v, but instead it returned %v.", test.s, test.expected, card)
+		}
+	}
+}
+
+func TestParseCardInvalid(t *testing.T) {
+	tests := []string{"", "A", "Ahh", "1h", "Ax", "ah", "10h"}
+	for _, test := range tests {
+		if _, err := ParseCard(test); err == nil {
+			t.Errorf("Expected ParseCard(%q) to return an error, but it didn't.", test)
+		}
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	expected := []Card{{Ace, Heart}, {King, Diamond}, {Seven, Club}, {Two, Spade}}
+	tests := []string{"Ah Kd 7c 2s", "Ah,Kd,7c,2s", "  Ah, Kd ,7c\t2s\n", "Ah,,Kd  7c , 2s"}
+	for _, test := range tests {
+		parsed, err := ParseCards(test)
+		if err != nil {
+			t.Errorf("Expected ParseCards(%q) not to return an error, but it returned %v.", test, err)
+		}
+		if !HandsEqual(parsed, expected) {
+			t.Errorf("Expected ParseCards(%q) to return %v, but instead it returned %v.", test, expected, parsed)
+		}
+	}
+	if parsed, err := ParseCards(" , "); err != nil || len(parsed) != 0 {
+		t.Errorf("Expected a list without cards to parse to no cards, but instead got %v and error %v.", parsed, err)
+	}
+}
+
+func TestParseCardsInvalid(t *testing.T) {
+	tests := []string{"Ah Kd Ah", "Ah, ah", "Ah Kx", "AhKd"}
+	for _, test := range tests {
+		if _, err := ParseCards(test); err == nil {
+			t.Errorf("Expected ParseCards(%q) to return an error, but it didn't.", test)
+		}
+	}
+	if _, err := ParseCards("Ah Kd Ah"); err == nil || !strings.Contains(err.Error(), "more than once") {
+		t.Errorf("Expected a duplicate card to be reported, but instead got error %v.", err)
+	}
+}
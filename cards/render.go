@@ -0,0 +1,68 @@
+package cards
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ANSI escape codes used to color the red suits in RenderHandANSI.
+const (
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+)
+
+// renderLabel returns the rank shown in the corners of a rendered card. Ex. "10" or "Q"
+func renderLabel(c Card) string {
+	if c.IsJoker() {
+		return "JK"
+	}
+	if c.rank == Ten {
+		return "10"
+	}
+	return rankSymbol(c.rank)
+}
+
+// RenderHand draws the cards side by side as boxes showing each card's rank and suit, for display in
+// a terminal. Ex. the Ace of Spades is drawn as:
+//
+//	┌─────┐
+//	│A    │
+//	│  ♠  │
+//	│    A│
+//	└─────┘
+func RenderHand(h Hand) string {
+	return renderHand(h, false)
+}
+
+// RenderHandANSI draws the cards in the same way as RenderHand, but colors hearts and diamonds red with
+// ANSI escape codes, for terminals that support them.
+func RenderHandANSI(h Hand) string {
+	return renderHand(h, true)
+}
+
+func renderHand(h Hand, color bool) string {
+	lines := make([][]string, 5)
+	for _, c := range h {
+		label := renderLabel(c)
+		glyph := string(c.suit.Symbol())
+		start, end := "", ""
+		if color && c.suit.Color() == "red" {
+			start, end = ansiRed, ansiReset
+		}
+		card := []string{
+			"┌─────┐",
+			fmt.Sprintf("│%v%-2v%v   │", start, label, end),
+			fmt.Sprintf("│  %v%v%v  │", start, glyph, end),
+			fmt.Sprintf("│   %v%2v%v│", start, label, end),
+			"└─────┘",
+		}
+		for i := range lines {
+			lines[i] = append(lines[i], card[i])
+		}
+	}
+	rendered := make([]string, len(lines))
+	for i, line := range lines {
+		rendered[i] = strings.Join(line, " ")
+	}
+	return strings.Join(rendered, "\n")
+}
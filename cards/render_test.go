@@ -0,0 +1,28 @@
+package cards
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderHand(t *testing.T) {
+	hand := Hand{{Ace, Spade}, {Ten, Heart}, {Queen, Diamond}, {Two, Club}}
+	rendered := RenderHand(hand)
+	for _, expected := range []string{"A", "♠", "10", "♥", "Q", "♦", "2", "♣"} {
+		if !strings.Contains(rendered, expected) {
+			t.Errorf("Expected the rendered hand to contain %q, but it didn't:\n%v", expected, rendered)
+		}
+	}
+	if lines := strings.Split(rendered, "\n"); len(lines) != 5 {
+		t.Errorf("Expected the rendered hand to be 5 lines, but instead it was %v.", len(lines))
+	}
+	if strings.Contains(rendered, ansiRed) {
+		t.Errorf("Expected RenderHand not to use ANSI colors, but it did.")
+	}
+	if !strings.Contains(RenderHandANSI(hand), ansiRed) {
+		t.Errorf("Expected RenderHandANSI to color the red suits, but it didn't.")
+	}
+	if strings.Contains(RenderHandANSI(Hand{{Ace, Spade}, {Two, Club}}), ansiRed) {
+		t.Errorf("Expected RenderHandANSI not to color the black suits, but it did.")
+	}
+}
@@ -0,0 +1,34 @@
+package cards
+
+import "fmt"
+
+// Result is the evaluation of the best five card hand that can be made from a set of cards.
+type Result struct {
+	Category    HandCategory
+	Cards       Hand   // the five cards that make up the best hand
+	Score       int    // compares the hand against others, see Score
+	Description string // Ex. "Full House, Kings full of Twos"
+}
+
+// EvaluateBestOfSeven evaluates the best five card hand that can be made from the cards, such as a
+// player's two hole cards and the five community cards. Any number of cards from five to seven can be
+// evaluated, which allows hands to be evaluated on the flop and turn. It returns an error if there are
+// too few or too many cards, or the same card appears twice, other than a joker.
+func EvaluateBestOfSeven(cards []Card) (Result, error) {
+	if len(cards) < 5 || len(cards) > 7 {
+		return Result{}, fmt.Errorf("Can't evaluate %v cards, there must be between 5 and 7.", len(cards))
+	}
+	seen := make(map[Card]bool)
+	for _, c := range cards {
+		// jokers are identical, so a hand can have more than one
+		if c.IsJoker() {
+			continue
+		}
+		if seen[c] {
+			return Result{}, fmt.Errorf("Can't evaluate cards containing %v more than once.", c)
+		}
+		seen[c] = true
+	}
+	best := BestHand(cards)
+	return Result{Category(best), best, Score(best), Describe(best)}, nil
+}
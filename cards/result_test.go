@@ -0,0 +1,139 @@
+package cards
+
+import "testing"
+
+func TestEvaluateBestOfSeven(t *testing.T) {
+	tests := []struct {
+		cards       []Card
+		category    HandCategory
+		best        Hand
+		description string
+	}{
+		{
+			[]Card{{Two, Club}, {Seven, Heart}, {Ace, Spade}, {Jack, Heart}, {King, Diamond}, {Nine, Club}, {Four, Spade}},
+			HighCard,
+			Hand{{Seven, Heart}, {Ace, Spade}, {Jack, Heart}, {King, Diamond}, {Nine, Club}},
+			"High Card, Ace",
+		},
+		{
+			[]Card{{Two, Club}, {Seven, Heart}, {Ace, Spade}, {Ace, Heart}, {King, Diamond}, {Nine, Club}, {Four, Spade}},
+			Pair,
+			Hand{{Seven, Heart}, {Ace, Spade}, {Ace, Heart}, {King, Diamond}, {Nine, Club}},
+			"Pair, Aces",
+		},
+		{
+			[]Card{{Two, Club}, {Seven, Heart}, {Seven, Spade}, {Ace, Heart}, {King, Diamond}, {King, Club}, {Four, Spade}},
+			TwoPair,
+			Hand{{Seven, Heart}, {Seven, Spade}, {Ace, Heart}, {King, Diamond}, {King, Club}},
+			"Two Pair, Kings and Sevens",
+		},
+		{
+			[]Card{{Two, Club}, {Seven, Heart}, {Seven, Spade}, {Seven, Club}, {King, Diamond}, {Queen, Club}, {Four, Spade}},
+			ThreeOfAKind,
+			Hand{{Seven, Heart}, {Seven, Spade}, {Seven, Club}, {King, Diamond}, {Queen, Club}},
+			"Three of a Kind, Sevens",
+		},
+		{
+			[]Card{{Two, Club}, {Six, Heart}, {Seven, Spade}, {Eight, Club}, {Nine, Diamond}, {Ten, Club}, {King, Spade}},
+			Straight,
+			Hand{{Six, Heart}, {Seven, Spade}, {Eight, Club}, {Nine, Diamond}, {Ten, Club}},
+			"Straight, Six to Ten",
+		},
+		{
+			[]Card{{Two, Club}, {Six, Club}, {Seven, Spade}, {Eight, Club}, {Nine, Club}, {Ace, Club}, {King, Spade}},
+			Flush,
+			Hand{{Two, Club}, {Six, Club}, {Eight, Club}, {Nine, Club}, {Ace, Club}},
+			"Flush, Ace high",
+		},
+		{
+			[]Card{{Two, Club}, {Two, Heart}, {King, Spade}, {King, Club}, {King, Diamond}, {Ten, Club}, {Ten, Spade}},
+			FullHouse,
+			Hand{{King, Spade}, {King, Club}, {King, Diamond}, {Ten, Club}, {Ten, Spade}},
+			"Full House, Kings full of Tens",
+		},
+		{
+			[]Card{{Nine, Club}, {Nine, Heart}, {Nine, Spade}, {Nine, Diamond}, {Two, Diamond}, {Ten, Club}, {Ten, Spade}},
+			FourOfAKind,
+			Hand{{Nine, Club}, {Nine, Heart}, {Nine, Spade}, {Nine, Diamond}, {Ten, Club}},
+			"Four of a Kind, Nines",
+		},
+		{
+			[]Card{{Ace, Heart}, {Two, Heart}, {Three, Heart}, {Four, Heart}, {Five, Heart}, {Ten, Club}, {Ten, Spade}},
+			StraightFlush,
+			Hand{{Ace, Heart}, {Two, Heart}, {Three, Heart}, {Four, Heart}, {Five, Heart}},
+			"Straight Flush, Ace to Five",
+		},
+		{
+			[]Card{{Ace, Heart}, {King, Heart}, {Queen, Heart}, {Jack, Heart}, {Ten, Heart}, {Nine, Heart}, {Ten, Spade}},
+			StraightFlush,
+			Hand{{Ace, Heart}, {King, Heart}, {Queen, Heart}, {Jack, Heart}, {Ten, Heart}},
+			"Royal Flush",
+		},
+		// Five and six cards can be evaluated on the flop and turn.
+		{
+			[]Card{{Two, Club}, {Two, Heart}, {King, Spade}, {King, Club}, {King, Diamond}},
+			FullHouse,
+			Hand{{Two, Club}, {Two, Heart}, {King, Spade}, {King, Club}, {King, Diamond}},
+			"Full House, Kings full of Twos",
+		},
+		{
+			[]Card{{Two, Club}, {Three, Heart}, {Four, Spade}, {Five, Club}, {Six, Diamond}, {Seven, Club}},
+			Straight,
+			Hand{{Three, Heart}, {Four, Spade}, {Five, Club}, {Six, Diamond}, {Seven, Club}},
+			"Straight, Three to Seven",
+		},
+	}
+	for _, test := range tests {
+		result, err := EvaluateBestOfSeven(test.cards)
+		if err != nil {
+			t.Fatalf("Expected EvaluateBestOfSeven(%v) not to return an error, but it returned %v.", test.cards, err)
+		}
+		if result.Category != test.category || !HandsEqual(result.Cards, test.best) || result.Description != test.description {
+			t.Errorf("Expected EvaluateBestOfSeven(%v) to return %v %v %q, but instead it returned %v %v %q.",
+				test.cards, test.category, test.best, test.description, result.Category, result.Cards, result.Description)
+		}
+		if result.Score != Score(test.cards) {
+			t.Errorf("Expected EvaluateBestOfSeven(%v) to score %X, but instead it scored %X.", test.cards, Score(test.cards), result.Score)
+		}
+	}
+}
+
+func TestEvaluateBestOfSevenTies(t *testing.T) {
+	board := []Card{{Ten, Spade}, {Jack, Heart}, {Queen, Diamond}, {King, Club}, {Ace, Spade}}
+	a, _ := EvaluateBestOfSeven(append([]Card{{Two, Club}, {Three, Heart}}, board...))
+	b, _ := EvaluateBestOfSeven(append([]Card{{Four, Club}, {Five, Heart}}, board...))
+	if a.Score != b.Score || !HandsEqual(a.Cards, b.Cards) {
+		t.Errorf("Expected both players to play the board and tie, but instead they scored %X and %X.", a.Score, b.Score)
+	}
+	// The same hand in different suits ties, but a better kicker doesn't.
+	c, _ := EvaluateBestOfSeven([]Card{{Ace, Club}, {Ace, Heart}, {Nine, Club}, {Four, Diamond}, {Two, Spade}, {Seven, Heart}, {Six, Club}})
+	d, _ := EvaluateBestOfSeven([]Card{{Ace, Spade}, {Ace, Diamond}, {Nine, Heart}, {Four, Spade}, {Two, Heart}, {Seven, Club}, {Six, Diamond}})
+	e, _ := EvaluateBestOfSeven([]Card{{Ace, Spade}, {Ace, Diamond}, {Ten, Heart}, {Four, Spade}, {Two, Heart}, {Seven, Club}, {Six, Diamond}})
+	if c.Score != d.Score || e.Score <= d.Score {
+		t.Errorf("Expected equal hands to tie and a better kicker to win, but instead they scored %X, %X, and %X.", c.Score, d.Score, e.Score)
+	}
+}
+
+func TestEvaluateBestOfSevenJokers(t *testing.T) {
+	result, err := EvaluateBestOfSeven([]Card{{Ten, Heart}, {Jack, Heart}, {Queen, Heart}, Joker, Joker, {Two, Club}, {Five, Diamond}})
+	if err != nil {
+		t.Fatalf("Expected a hand with two jokers to be evaluated, but instead got error %v.", err)
+	}
+	if result.Category != StraightFlush {
+		t.Errorf("Expected the jokers to make a straight flush, but instead the hand was a %v.", result.Category)
+	}
+}
+
+func TestEvaluateBestOfSevenInvalid(t *testing.T) {
+	tests := [][]Card{
+		{},
+		{{Two, Club}, {Three, Heart}, {Four, Spade}, {Five, Club}},
+		{{Two, Club}, {Three, Heart}, {Four, Spade}, {Five, Club}, {Six, Club}, {Seven, Club}, {Eight, Club}, {Nine, Club}},
+		{{Two, Club}, {Three, Heart}, {Four, Spade}, {Five, Club}, {Two, Club}},
+	}
+	for _, cards := range tests {
+		if _, err := EvaluateBestOfSeven(cards); err == nil {
+			t.Errorf("Expected EvaluateBestOfSeven(%v) to return an error, but it didn't.", cards)
+		}
+	}
+}
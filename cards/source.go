@@ -0,0 +1,56 @@
+package cards
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Source is a rand.Source whose state can be copied, unlike the sources of the math/rand package. It
+// produces the same numbers as rand.NewSource with the same seed, and a copy produces the same numbers
+// as the original from the point it was copied, so that decks shuffled from a source can be reproduced
+// without sharing it.
+type Source struct {
+	seed  int64
+	drawn int // numbers drawn since the source was seeded
+	src   rand.Source64
+}
+
+// NewSource returns a Source seeded with the specified seed.
+func NewSource(seed int64) *Source {
+	s := &Source{}
+	s.Seed(seed)
+	return s
+}
+
+// NewTimeSource returns a Source seeded from the current time.
+func NewTimeSource() *Source {
+	return NewSource(time.Now().UnixNano())
+}
+
+// Seed resets the source to the state of a new source with the specified seed.
+func (s *Source) Seed(seed int64) {
+	s.seed, s.drawn = seed, 0
+	s.src = rand.NewSource(seed).(rand.Source64)
+}
+
+// Int63 returns a non-negative pseudo-random 63-bit integer.
+func (s *Source) Int63() int64 {
+	s.drawn++
+	return s.src.Int63()
+}
+
+// Uint64 returns a pseudo-random 64-bit integer.
+func (s *Source) Uint64() uint64 {
+	s.drawn++
+	return s.src.Uint64()
+}
+
+// Copy returns a source in the same state that can be drawn from without changing this one. It takes
+// time proportional to the numbers drawn since the source was seeded.
+func (s *Source) Copy() *Source {
+	c := NewSource(s.seed)
+	for c.drawn < s.drawn {
+		c.Uint64()
+	}
+	return c
+}
@@ -0,0 +1,32 @@
+package cards
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSource(t *testing.T) {
+	source, standard := NewSource(42), rand.NewSource(42)
+	for i := 0; i < 5; i++ {
+		if a, b := source.Int63(), standard.Int63(); a != b {
+			t.Fatalf("Expected draw %v to match rand.NewSource with the same seed, but instead it was %v rather than %v.", i, a, b)
+		}
+	}
+
+	// A copy continues from the same point without advancing the original.
+	copied := source.Copy()
+	first := copied.Int63()
+	copied.Uint64()
+	if next := source.Int63(); next != first {
+		t.Errorf("Expected the original and its copy to draw the same number, but instead they drew %v and %v.", next, first)
+	}
+}
+
+func TestGenerateDeckFrom(t *testing.T) {
+	source := NewSource(7)
+	a := GenerateDeckFrom(rand.New(source.Copy()))
+	b := GenerateDeckFrom(rand.New(source))
+	if !HandsEqual(a.GetCards(), b.GetCards()) {
+		t.Errorf("Expected decks shuffled from sources in the same state to match, but instead they were %v and %v.", a.GetCards(), b.GetCards())
+	}
+}
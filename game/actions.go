@@ -0,0 +1,64 @@
+package game
+
+import "fmt"
+
+// ActionType is the kind of move a player makes on their turn.
+type ActionType int8
+
+const (
+	FoldAction  ActionType = 0
+	CheckAction ActionType = 1
+	CallAction  ActionType = 2
+	BetAction   ActionType = 3
+	RaiseAction ActionType = 4
+)
+
+var actionNames = map[ActionType]string{
+	FoldAction:  "fold",
+	CheckAction: "check",
+	CallAction:  "call",
+	BetAction:   "bet",
+	RaiseAction: "raise",
+}
+
+func (a ActionType) String() string {
+	if name, ok := actionNames[a]; ok {
+		return name
+	}
+	return fmt.Sprintf("ActionType(%d)", int8(a))
+}
+
+// Action is a move made by a player. Amount is only used by bets and raises, where it has the same
+// meaning as the amount passed to Bet and Raise.
+type Action struct {
+	Type   ActionType
+	Amount int
+}
+
+// Apply performs the action for the specified player.
+func (g *GameState) Apply(playerID int, a Action) error {
+	switch a.Type {
+	case FoldAction:
+		return g.Fold(playerID)
+	case CheckAction:
+		return g.Check(playerID)
+	case CallAction:
+		return g.Call(playerID)
+	case BetAction:
+		return g.Bet(playerID, a.Amount)
+	case RaiseAction:
+		return g.Raise(playerID, a.Amount)
+	default:
+		return fmt.Errorf("error applying action: unknown action type %v", a.Type)
+	}
+}
+
+// ApplyImmutable returns the state that results from the specified player performing the action,
+// leaving the current state unchanged.
+func (g GameState) ApplyImmutable(playerID int, a Action) (GameState, error) {
+	next := g.Clone()
+	if err := next.Apply(playerID, a); err != nil {
+		return GameState{}, err
+	}
+	return next, nil
+}
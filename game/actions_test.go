@@ -0,0 +1,50 @@
+package game
+
+import "testing"
+
+func TestApply(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	if err := g.Apply(2, Action{RaiseAction, 4}); err != nil {
+		t.Fatalf("Expected player 2 to be able to raise, but there was an error: %v", err)
+	}
+	if g.highestBetInRound != 8 || g.table[2].money != 92 {
+		t.Errorf("Expected player 2 to raise to 8, but the highest bet is %v and they have %v left.",
+			g.highestBetInRound,
+			g.table[2].money)
+	}
+	if err := g.Apply(2, Action{Type: ActionType(42)}); err == nil {
+		t.Errorf("Expected an error applying an unknown action, but there wasn't one.")
+	}
+}
+
+func TestApplyImmutable(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	original := g.Clone()
+
+	next, err := g.ApplyImmutable(2, Action{Type: FoldAction})
+	if err != nil {
+		t.Fatalf("Expected player 2 to be able to fold, but there was an error: %v", err)
+	}
+	if intInSlice(2, next.participating) {
+		t.Errorf("Expected player 2 to have folded in the resulting state.")
+	}
+	if !intSlicesEqual(g.participating, original.participating) {
+		t.Errorf("Expected the original participants to be unchanged, but they are %v instead of %v.",
+			g.participating,
+			original.participating)
+	}
+
+	next, _ = g.ApplyImmutable(2, Action{RaiseAction, 4})
+	if next.potSize() != original.potSize()+8 {
+		t.Errorf("Expected the resulting pot to be %v, but it was %v.", original.potSize()+8, next.potSize())
+	}
+	if g.potSize() != original.potSize() || g.table[2].money != original.table[2].money || g.highestBetInRound != original.highestBetInRound {
+		t.Errorf("Expected the original state to be unchanged after applying a raise.")
+	}
+
+	if _, err := g.ApplyImmutable(0, Action{Type: CheckAction}); err == nil {
+		t.Errorf("Expected an error applying an action out of turn, but there wasn't one.")
+	}
+}
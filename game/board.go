@@ -0,0 +1,82 @@
+package game
+
+import "github.com/Chris-Behan/gopoker/cards"
+
+// BoardPlays returns true if the five community cards are the best possible hand, meaning no hole
+// cards can improve on them and every player remaining at showdown splits the pot.
+func BoardPlays(board []cards.Card) bool {
+	if len(board) != 5 {
+		return false
+	}
+	remaining := []cards.Card{}
+	for _, c := range cards.AllCards() {
+		if !cardInSlice(c, board) {
+			remaining = append(remaining, c)
+		}
+	}
+	for i := 0; i < len(remaining); i++ {
+		for j := i + 1; j < len(remaining); j++ {
+			if cards.CompareOnBoard([]cards.Card{remaining[i], remaining[j]}, []cards.Card{}, board) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MaxHandInRange returns the strongest hand that any hole cards in the opponent's range make with the
+// board, along with its category. With every starting hand as the range this is the nuts. Hole cards
+// that share a card with the board can't be dealt, so they are ignored. It returns an empty hand if
+// every hand in the range is ignored.
+func MaxHandInRange(board []cards.Card, oppRange [][2]cards.Card) (cards.Hand, cards.HandCategory) {
+	onBoard := cards.NewCardSet(board)
+	var best []cards.Card
+	for _, hole := range oppRange {
+		if onBoard.Contains(hole[0]) || onBoard.Contains(hole[1]) {
+			continue
+		}
+		all := append([]cards.Card{hole[0], hole[1]}, board...)
+		if best == nil || cards.CompareHands(all, best) > 0 {
+			best = all
+		}
+	}
+	if best == nil {
+		return cards.Hand{}, cards.HighCard
+	}
+	return cards.BestHand(best), cards.Category(best)
+}
+
+// BoardTexture counts how many of the hole card combinations that can still be dealt make each hand
+// category with the board, which shows how "wet" or "dry" the board is. Categories that no combination
+// makes aren't included.
+func BoardTexture(board []cards.Card) map[cards.HandCategory]int {
+	remaining := cards.NewCardSet(cards.AllCards())
+	for _, c := range board {
+		remaining.Remove(c)
+	}
+	counts := make(map[cards.HandCategory]int)
+	cards.ForEachCombination(remaining.Cards(), 2, func(hole []cards.Card) {
+		counts[cards.Category(append([]cards.Card{hole[0], hole[1]}, board...))]++
+	})
+	return counts
+}
+
+// Blockers returns how many of the opponent's hole card combinations that would make the target
+// category with the board can't be dealt because the player holds one of their cards. Ex. holding the
+// ace of hearts on a board with three hearts blocks every flush made with the ace of hearts.
+func Blockers(hole [2]cards.Card, board []cards.Card, targetCategory cards.HandCategory) int {
+	remaining := cards.NewCardSet(cards.AllCards())
+	for _, c := range board {
+		remaining.Remove(c)
+	}
+	blocked := 0
+	cards.ForEachCombination(remaining.Cards(), 2, func(opp []cards.Card) {
+		if !cardInSlice(hole[0], opp) && !cardInSlice(hole[1], opp) {
+			return
+		}
+		if cards.Category(append([]cards.Card{opp[0], opp[1]}, board...)) == targetCategory {
+			blocked++
+		}
+	})
+	return blocked
+}
@@ -0,0 +1,138 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestBoardPlays(t *testing.T) {
+	tests := []struct {
+		board    string
+		expected bool
+	}{
+		{"Th Jd Qc Ks As", true},
+		{"5h 6d 7c 8s 9s", false},
+		{"Ah Ad Ac As Ks", true},
+		{"Ah Kh Qh Jh 9h", false},
+		{"Ah Kd Qc", false},
+	}
+	for _, test := range tests {
+		board := []cards.Card{}
+		for _, s := range strings.Fields(test.board) {
+			c, _ := cards.ParseCard(s)
+			board = append(board, c)
+		}
+		if result := BoardPlays(board); result != test.expected {
+			t.Errorf("Expected BoardPlays(%v) to return %v, but instead it returned %v.", test.board, test.expected, result)
+		}
+	}
+}
+
+// Tests that players split the pot when their best hand is a straight on the board.
+func TestDistributePotBoardPlays(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.participating = []int{0, 1}
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.Two, cards.Club), cards.NewCard(cards.Three, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.Seven, cards.Club), cards.NewCard(cards.Seven, cards.Diamond)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Ten, cards.Heart),
+		cards.NewCard(cards.Jack, cards.Spade),
+		cards.NewCard(cards.Queen, cards.Diamond),
+		cards.NewCard(cards.King, cards.Club),
+		cards.NewCard(cards.Ace, cards.Heart),
+	}
+	commit(&g, map[int]int{0: 30, 1: 30})
+
+	result, _ := g.DistributePot()
+	if result.Winnings[0] != 30 || result.Winnings[1] != 30 {
+		t.Errorf("Expected both players to split the pot when the board plays, but instead they won %v.", result.Winnings)
+	}
+	for _, id := range []int{0, 1} {
+		best := cards.BestHand(g.playerCards(id))
+		for _, c := range best {
+			if !cardInSlice(c, g.community) {
+				t.Errorf("Expected player %v's best hand to be the board, but instead it was %v.", id, best)
+			}
+		}
+	}
+}
+
+func TestMaxHandInRange(t *testing.T) {
+	board := parseCards("Ah Kh Qh 7c 7d")
+	nuts, nutsCategory := MaxHandInRange(board, AllStartingHands())
+	if nutsCategory != cards.StraightFlush || cards.CompareHands(nuts, parseCards("Ah Kh Qh Jh Th")) != 0 {
+		t.Errorf("Expected the nuts to be a royal flush, but instead it was %v (%v).", nuts, nutsCategory)
+	}
+	tests := []struct {
+		oppRange string
+		category cards.HandCategory
+	}{
+		{"AA,KK", cards.FullHouse},
+		{"77", cards.FourOfAKind},
+		{"JTs", cards.StraightFlush},
+		{"JTo", cards.Straight},
+		{"98o", cards.Pair},
+	}
+	for _, test := range tests {
+		oppRange, _ := ParseRange(test.oppRange)
+		hand, category := MaxHandInRange(board, oppRange)
+		if category != test.category {
+			t.Errorf("Expected the best hand in %q to be a %v, but instead it was %v (%v).", test.oppRange, test.category, hand, category)
+		}
+		if cards.CompareHands(hand, nuts) > 0 {
+			t.Errorf("Expected the best hand in %q not to beat the nuts, but %v did.", test.oppRange, hand)
+		}
+	}
+	// Every seven is on the board, so hole cards with a seven can't be dealt.
+	if hand, _ := MaxHandInRange(parseCards("7h 7c 7d 7s"), [][2]cards.Card{{cards.NewCard(cards.Seven, cards.Heart), cards.NewCard(cards.Ace, cards.Spade)}}); len(hand) != 0 {
+		t.Errorf("Expected no hand when every combination in the range is blocked by the board, but instead it was %v.", hand)
+	}
+}
+
+func TestBoardTexture(t *testing.T) {
+	monotone := BoardTexture(parseCards("Ah 9h 4h"))
+	rainbow := BoardTexture(parseCards("Ah 9d 4c"))
+	for name, texture := range map[string]map[cards.HandCategory]int{"monotone": monotone, "rainbow": rainbow} {
+		total := 0
+		for _, count := range texture {
+			total += count
+		}
+		if total != 1176 {
+			t.Errorf("Expected the %v flop to count all 1176 hole card combinations, but instead it counted %v.", name, total)
+		}
+	}
+	// Any two hearts make a flush on the monotone flop, and no hole cards do on the rainbow flop.
+	if monotone[cards.Flush] != 45 || rainbow[cards.Flush] != 0 {
+		t.Errorf("Expected 45 flushes on the monotone flop and none on the rainbow flop, but instead there were %v and %v.",
+			monotone[cards.Flush], rainbow[cards.Flush])
+	}
+	if monotone[cards.HighCard] >= rainbow[cards.HighCard] {
+		t.Errorf("Expected fewer hands to be high card on the monotone flop, since two hearts make a flush, but there were %v and %v.",
+			monotone[cards.HighCard], rainbow[cards.HighCard])
+	}
+}
+
+func TestBlockers(t *testing.T) {
+	board := parseCards("Kh 7h 2h 9c 4s")
+	tests := []struct {
+		name     string
+		hole     string
+		category cards.HandCategory
+		expected int
+	}{
+		// The ace of hearts pairs with each of the other 9 hearts left to make a flush.
+		{"nut flush blocker", "Ah Qd", cards.Flush, 9},
+		{"two flush blockers", "Ah Qh", cards.Flush, 17},
+		{"no flush blockers", "Qd Js", cards.Flush, 0},
+		// Each card blocks two of the three pocket pairs that make a set with the board.
+		{"set blockers", "7c 9d", cards.ThreeOfAKind, 4},
+	}
+	for _, test := range tests {
+		hole := parseCards(test.hole)
+		if blocked := Blockers([2]cards.Card{hole[0], hole[1]}, board, test.category); blocked != test.expected {
+			t.Errorf("%v: Expected %v to block %v combinations making a %v, but instead it blocked %v.", test.name, test.hole, test.expected, test.category, blocked)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BlindLevel is a level of a blind schedule, whose blinds are played until its duration has elapsed.
+type BlindLevel struct {
+	SmallBlind int
+	BigBlind   int
+	Duration   time.Duration
+}
+
+// blindClock tracks the level of a game's blind schedule.
+type blindClock struct {
+	schedule []BlindLevel
+	level    int           // index of the current level in the schedule
+	elapsed  time.Duration // time played at the current level
+}
+
+// SetBlindSchedule plays the game with blinds that increase on a clock, starting from the first level of
+// the schedule. The clock is advanced with TickClock, and the blinds of the current level are posted from
+// the next hand on. The last level lasts until the end of the game. It returns an error if the schedule
+// is empty, if a level's blinds or duration aren't positive or its small blind isn't less than its big
+// blind, or if a rule set of the game's rotation has no small blind at a level's big blind.
+func (g *GameState) SetBlindSchedule(levels []BlindLevel) error {
+	if len(levels) == 0 {
+		return errors.New("error setting blind schedule: the schedule must have at least one level")
+	}
+	for i, level := range levels {
+		if level.SmallBlind <= 0 || level.SmallBlind >= level.BigBlind || level.Duration <= 0 {
+			return fmt.Errorf("error setting blind schedule: level %v has blinds of $%v/$%v and a duration of %v", i+1, level.SmallBlind, level.BigBlind, level.Duration)
+		}
+		for j, rules := range g.rotation.variants {
+			if _, err := rules.smallBlindFor(level.BigBlind); err != nil {
+				return fmt.Errorf("error setting blind schedule: level %v: rule set %v of the rotation: %v", i+1, j+1, err)
+			}
+		}
+	}
+	g.clock = blindClock{append([]BlindLevel{}, levels...), 0, 0}
+	return nil
+}
+
+// TickClock advances the blind clock by the time that has elapsed, moving to the next level of the
+// schedule each time a level's duration runs out, so a long enough tick can skip several levels. The new
+// blinds are posted from the next hand on. It does nothing if the game has no blind schedule.
+func (g *GameState) TickClock(elapsed time.Duration) {
+	if len(g.clock.schedule) == 0 {
+		return
+	}
+	g.clock.elapsed += elapsed
+	for g.clock.level < len(g.clock.schedule)-1 && g.clock.elapsed >= g.clock.schedule[g.clock.level].Duration {
+		g.clock.elapsed -= g.clock.schedule[g.clock.level].Duration
+		g.clock.level++
+	}
+}
+
+// CurrentBlindLevel returns the number of the current level of the blind schedule, starting at 1, and the time
+// left until the next level. The last level has no time left. It returns 0 if the game has no blind schedule.
+func (g GameState) CurrentBlindLevel() (int, time.Duration) {
+	if len(g.clock.schedule) == 0 {
+		return 0, 0
+	}
+	if g.clock.level == len(g.clock.schedule)-1 {
+		return g.clock.level + 1, 0
+	}
+	return g.clock.level + 1, g.clock.schedule[g.clock.level].Duration - g.clock.elapsed
+}
+
+// Sets the blind amounts to those of the current level of the blind schedule, if the game has one.
+func (g *GameState) applyBlindLevel() {
+	if len(g.clock.schedule) == 0 {
+		return
+	}
+	level := g.clock.schedule[g.clock.level]
+	g.smallBlindAmount, g.bigBlindAmount = level.SmallBlind, level.BigBlind
+}
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTickClock(t *testing.T) {
+	g, _ := NewGame(3, 1000, 4)
+	schedule := []BlindLevel{{5, 10, 10 * time.Minute}, {10, 20, 10 * time.Minute}, {25, 50, 15 * time.Minute}}
+	if err := g.SetBlindSchedule(schedule); err != nil {
+		t.Fatalf("Expected the schedule to be valid, but instead got error %v.", err)
+	}
+	steps := []struct {
+		elapsed  time.Duration
+		level    int
+		left     time.Duration
+		bigBlind int
+	}{
+		{0, 1, 10 * time.Minute, 10},
+		{9 * time.Minute, 1, time.Minute, 10},
+		// crossing the level boundary carries the extra time into the next level
+		{2 * time.Minute, 2, 9 * time.Minute, 20},
+		// a long tick skips to the last level, which never ends
+		{time.Hour, 3, 0, 50},
+	}
+	for _, step := range steps {
+		g.TickClock(step.elapsed)
+		level, left := g.CurrentBlindLevel()
+		if level != step.level || left != step.left {
+			t.Errorf("Expected level %v with %v left, but instead it was level %v with %v left.", step.level, step.left, level, left)
+		}
+		g.newRound()
+		if g.bigBlindAmount != step.bigBlind || g.highestBetInRound != step.bigBlind {
+			t.Errorf("Expected a big blind of %v to be posted at level %v, but instead it was %v.", step.bigBlind, level, g.highestBetInRound)
+		}
+		g.DistributePot()
+	}
+}
+
+func TestSetBlindScheduleInvalid(t *testing.T) {
+	tests := [][]BlindLevel{
+		{},
+		{{5, 10, 0}},
+		{{5, 10, time.Minute}, {20, 10, time.Minute}},
+		{{0, 10, time.Minute}},
+		{{10, 10, time.Minute}},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(3, 100, 4)
+		if err := g.SetBlindSchedule(test); err == nil {
+			t.Errorf("Expected the schedule %v to return an error, but it didn't.", test)
+		}
+	}
+	g, _ := NewGame(3, 100, 4)
+	g.TickClock(time.Hour)
+	if level, _ := g.CurrentBlindLevel(); level != 0 || g.bigBlindAmount != 4 {
+		t.Errorf("Expected the clock not to change a game without a schedule, but it was at level %v with a big blind of %v.", level, g.bigBlindAmount)
+	}
+}
+
+func TestStackInBigBlinds(t *testing.T) {
+	g, _ := NewGame(3, 1000, 20)
+	if bbs := g.StackInBigBlinds(0); bbs != 50 {
+		t.Errorf("Expected a stack of 1000 to be 50 big blinds of 20, but instead it was %v.", bbs)
+	}
+	if bbs := g.StackInBigBlinds(5); bbs != 0 {
+		t.Errorf("Expected a player who doesn't exist to have 0 big blinds, but instead they had %v.", bbs)
+	}
+	g.SetBlindSchedule([]BlindLevel{{10, 20, 10 * time.Minute}, {20, 40, 10 * time.Minute}})
+	g.TickClock(10 * time.Minute)
+	if bbs := g.StackInBigBlinds(0); bbs != 25 {
+		t.Errorf("Expected a stack of 1000 to be 25 big blinds once the big blind increased to 40, but instead it was %v.", bbs)
+	}
+}
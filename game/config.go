@@ -0,0 +1,124 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// SeatConfig is the seat and starting stack of a player in a GameConfig.
+type SeatConfig struct {
+	Seat  int // the seat's index at the table, which becomes the player's id
+	Stack int
+}
+
+// GameConfig describes the seating of a game, for reconstructing a specific situation, replaying a hand,
+// or resuming a saved game.
+type GameConfig struct {
+	NumSeats       int          // seats at the table, seats without a player are empty
+	Players        []SeatConfig // the occupied seats
+	Button         int          // seat of the dealer button
+	SmallBlind     int          // seat of the small blind
+	BigBlind       int          // seat of the big blind
+	BigBlindAmount int
+	Rules          Rules
+	Source         *cards.Source // source every deck of the game is shuffled from, as in NewGameWithRules
+}
+
+// NewGameFromConfig creates a game with the players, stacks, button, and blinds described by the config.
+// Each player's id is the index of their seat, and the first round is played with the configured button
+// and blinds. Every deck is shuffled from the config's Source, if it has one. It returns an error if the
+// table has fewer than two seats or more than the rules' table size, fewer than two players, a seat that
+// is out of range or taken twice, a player without chips, a button or blind seat without a player, or
+// blinds that aren't the next players clockwise from the button, as they would be after the button moves.
+func NewGameFromConfig(cfg GameConfig) (GameState, error) {
+	g, err := NewGameWithRules(cfg.NumSeats, 0, cfg.BigBlindAmount, cfg.Rules, cfg.Source)
+	if err != nil {
+		return GameState{}, err
+	}
+	if len(cfg.Players) < 2 {
+		return GameState{}, fmt.Errorf("error creating game: must have at least 2 players, not %v", len(cfg.Players))
+	}
+	for i := range g.table {
+		g.table[i].alive = false
+	}
+	for _, p := range cfg.Players {
+		if p.Seat < 0 || p.Seat >= cfg.NumSeats {
+			return GameState{}, fmt.Errorf("error creating game: there is no seat %v at a table with %v seats", p.Seat, cfg.NumSeats)
+		}
+		if g.table[p.Seat].alive {
+			return GameState{}, fmt.Errorf("error creating game: seat %v is taken by more than one player", p.Seat)
+		}
+		if p.Stack <= 0 {
+			return GameState{}, fmt.Errorf("error creating game: the player in seat %v must have a stack of at least $1, not $%v", p.Seat, p.Stack)
+		}
+		g.table[p.Seat].alive = true
+		g.table[p.Seat].money = p.Stack
+	}
+	positions := []struct {
+		name string
+		seat int
+	}{{"button", cfg.Button}, {"small blind", cfg.SmallBlind}, {"big blind", cfg.BigBlind}}
+	for _, pos := range positions {
+		if pos.seat < 0 || pos.seat >= cfg.NumSeats || !g.table[pos.seat].alive {
+			return GameState{}, fmt.Errorf("error creating game: the %v is in seat %v, which has no player", pos.name, pos.seat)
+		}
+	}
+	// the blinds must be where the button would put them, heads-up the button posts the small blind
+	smallBlind := g.aliveClockwiseToPlayer(cfg.Button)
+	if len(cfg.Players) == 2 {
+		smallBlind = cfg.Button
+	}
+	if cfg.SmallBlind != smallBlind || cfg.BigBlind != g.aliveClockwiseToPlayer(smallBlind) {
+		return GameState{}, fmt.Errorf("error creating game: with the button in seat %v the blinds must be in seats %v and %v, not %v and %v",
+			cfg.Button, smallBlind, g.aliveClockwiseToPlayer(smallBlind), cfg.SmallBlind, cfg.BigBlind)
+	}
+	g.buttonPos, g.smallBlindPos, g.bigBlindPos = cfg.Button, cfg.SmallBlind, cfg.BigBlind
+	return g, nil
+}
+
+// PlayerConfig is a player joining a game that is already running.
+type PlayerConfig struct {
+	Stack int
+}
+
+// JoinTable seats a new player between hands and returns their id. The player takes the first empty seat,
+// which is any seat whose player is out of the game, or a new seat at the end of the table if every seat
+// is taken. A player who posts a blind is dealt into the next hand and posts a big blind, unless they
+// are already in the blinds, and the posted blind counts towards their bet. A player who doesn't post sits
+// out until the big blind reaches them. It returns an error in a tournament, where players join through
+// Tournament.JoinTable, while a hand is being played, if the player has no chips, or if the table is full,
+// with every seat taken and as many seats as the rules' table size.
+func (g *GameState) JoinTable(cfg PlayerConfig, postBlind bool) (int, error) {
+	if g.rules.Mode == TournamentGame {
+		return -1, errors.New("error joining table: players join a tournament table through the tournament")
+	}
+	return g.seatPlayer(cfg, postBlind)
+}
+
+// Seats a new player as JoinTable does, in a cash game or a tournament.
+func (g *GameState) seatPlayer(cfg PlayerConfig, postBlind bool) (int, error) {
+	if g.handInProgress() {
+		return -1, errors.New("error joining table: players can only join between hands")
+	}
+	if cfg.Stack <= 0 {
+		return -1, fmt.Errorf("error joining table: must join with a stack of at least $1, not $%v", cfg.Stack)
+	}
+	seat := -1
+	for i := range g.table {
+		if !g.table[i].alive {
+			seat = i
+			break
+		}
+	}
+	if seat == -1 {
+		if len(g.table) >= g.rules.maxSeats() {
+			return -1, fmt.Errorf("error joining table: the table is full with %v players", len(g.table))
+		}
+		seat = len(g.table)
+		g.table = append(g.table, player{})
+	}
+	g.table[seat] = player{seat, [2]cards.Card{}, cfg.Stack, true, 0, false, [2]bool{}, !postBlind, postBlind, false}
+	return seat, nil
+}
@@ -0,0 +1,161 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestNewGameFromConfig(t *testing.T) {
+	cfg := GameConfig{
+		NumSeats:       6,
+		Players:        []SeatConfig{{1, 200}, {3, 150}, {4, 80}},
+		Button:         4,
+		SmallBlind:     1,
+		BigBlind:       3,
+		BigBlindAmount: 10,
+	}
+	g, err := NewGameFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("Expected a valid config not to return an error, but it returned %v.", err)
+	}
+	if g.AlivePlayerCount() != 3 || g.ButtonPosition() != 4 {
+		t.Errorf("Expected 3 players with the button in seat 4, but instead there were %v players with the button in seat %v.",
+			g.AlivePlayerCount(), g.ButtonPosition())
+	}
+	g.newRound()
+	// The first round is played with the configured blinds, and the player after the big blind acts first.
+	expected := map[int]int{0: 0, 1: 195, 2: 0, 3: 140, 4: 80, 5: 0}
+	for id, money := range expected {
+		if stack, _ := g.Stack(id); stack != money {
+			t.Errorf("Expected seat %v to have a stack of %v, but instead it was %v.", id, money, stack)
+		}
+	}
+	if g.whoseTurn != 4 {
+		t.Errorf("Expected seat 4 to act first, but instead it was seat %v's turn.", g.whoseTurn)
+	}
+}
+
+func TestNewGameFromConfigInvalid(t *testing.T) {
+	valid := func() GameConfig {
+		return GameConfig{NumSeats: 4, Players: []SeatConfig{{0, 100}, {1, 100}, {2, 100}}, Button: 0, SmallBlind: 1, BigBlind: 2, BigBlindAmount: 4}
+	}
+	tests := []struct {
+		name   string
+		modify func(cfg *GameConfig)
+	}{
+		{"too many seats", func(cfg *GameConfig) { cfg.NumSeats = MaxPlayers + 1 }},
+		{"one player", func(cfg *GameConfig) { cfg.Players = cfg.Players[:1] }},
+		{"seat taken twice", func(cfg *GameConfig) { cfg.Players[2].Seat = 1 }},
+		{"seat out of range", func(cfg *GameConfig) { cfg.Players[2].Seat = 4 }},
+		{"no stack", func(cfg *GameConfig) { cfg.Players[0].Stack = 0 }},
+		{"empty button seat", func(cfg *GameConfig) { cfg.Button = 3 }},
+		{"empty small blind seat", func(cfg *GameConfig) { cfg.SmallBlind = 3 }},
+		{"big blind seat out of range", func(cfg *GameConfig) { cfg.BigBlind = -1 }},
+		{"blinds in the same seat", func(cfg *GameConfig) { cfg.BigBlind = 1 }},
+		{"blinds out of order", func(cfg *GameConfig) { cfg.SmallBlind, cfg.BigBlind = 2, 1 }},
+		{"small blind not after the button", func(cfg *GameConfig) { cfg.Button = 1 }},
+	}
+	if _, err := NewGameFromConfig(valid()); err != nil {
+		t.Fatalf("Expected the unmodified config to be valid, but instead got error %v.", err)
+	}
+	for _, test := range tests {
+		cfg := valid()
+		test.modify(&cfg)
+		if _, err := NewGameFromConfig(cfg); err == nil {
+			t.Errorf("%v: Expected NewGameFromConfig to return an error, but it didn't.", test.name)
+		}
+	}
+}
+
+func TestJoinTable(t *testing.T) {
+	newTable := func() GameState {
+		cfg := GameConfig{NumSeats: 5, Players: []SeatConfig{{0, 100}, {1, 100}, {2, 100}}, Button: 0, SmallBlind: 1, BigBlind: 2, BigBlindAmount: 10}
+		g, _ := NewGameFromConfig(cfg)
+		return g
+	}
+
+	// A player who posts is dealt in immediately and their blind counts towards their bet.
+	g := newTable()
+	id, err := g.JoinTable(PlayerConfig{Stack: 150}, true)
+	if err != nil || id != 3 {
+		t.Fatalf("Expected the player to join in the first empty seat 3, but instead got seat %v and error %v.", id, err)
+	}
+	g.newRound()
+	if !intInSlice(3, g.participating) {
+		t.Errorf("Expected a player who posted to be dealt in, but they weren't participating.")
+	}
+	if stack, _ := g.Stack(3); stack != 140 {
+		t.Errorf("Expected a player who posted to have a stack of 140, but instead it was %v.", stack)
+	}
+	if g.whoseTurn != 3 || g.validateCheck(3) != nil {
+		t.Errorf("Expected a player who posted to act first and be able to check, but it was player %v's turn.", g.whoseTurn)
+	}
+
+	// A player who doesn't post sits out until the big blind reaches them.
+	g = newTable()
+	id, _ = g.JoinTable(PlayerConfig{Stack: 150}, false)
+	g.newRound()
+	if intInSlice(id, g.participating) {
+		t.Errorf("Expected a player who didn't post to sit out the first hand, but they were participating.")
+	}
+	if stack, _ := g.Stack(id); stack != 150 {
+		t.Errorf("Expected a player who didn't post to keep their stack of 150, but instead it was %v.", stack)
+	}
+	g.DistributePot()
+	g.newRound()
+	if g.bigBlindPos != id || !intInSlice(id, g.participating) {
+		t.Errorf("Expected the player to be dealt in once they reached the big blind, but the big blind was seat %v and participating was %v.",
+			g.bigBlindPos, g.participating)
+	}
+	if stack, _ := g.Stack(id); stack != 140 {
+		t.Errorf("Expected the player to post the big blind leaving a stack of 140, but instead it was %v.", stack)
+	}
+}
+
+func TestJoinTableInvalid(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	if _, err := g.JoinTable(PlayerConfig{Stack: 0}, true); err == nil {
+		t.Errorf("Expected joining without chips to return an error, but it didn't.")
+	}
+	g.newRound()
+	if _, err := g.JoinTable(PlayerConfig{Stack: 100}, true); err == nil {
+		t.Errorf("Expected joining during a hand to return an error, but it didn't.")
+	}
+	full, _ := NewGame(MaxPlayers, 100, 4)
+	if _, err := full.JoinTable(PlayerConfig{Stack: 100}, false); err == nil {
+		t.Errorf("Expected joining a full table to return an error, but it didn't.")
+	}
+}
+
+func TestNewGameFromConfigSource(t *testing.T) {
+	// Both games are created from the same source, and a clone of the first plays a hand of its own
+	// before the games deal theirs.
+	source := cards.NewSource(42)
+	cfg := GameConfig{NumSeats: 3, Players: []SeatConfig{{0, 100}, {1, 100}, {2, 100}}, Button: 0, SmallBlind: 1, BigBlind: 2, BigBlindAmount: 4,
+		Source: source}
+	a, _ := NewGameFromConfig(cfg)
+	b, _ := NewGameFromConfig(cfg)
+	clone := a.Clone()
+	clone.newRound()
+	for hand := 0; hand < 3; hand++ {
+		a.newRound()
+		b.newRound()
+		a.dealCommunityCards(5)
+		b.dealCommunityCards(5)
+		for id := range a.table {
+			if a.table[id].hand != b.table[id].hand {
+				t.Errorf("Expected player %v to be dealt the same cards in hand %v, but instead they were %v and %v.", id, hand, a.table[id].hand, b.table[id].hand)
+			}
+		}
+		if !cards.HandsEqual(a.community, b.community) {
+			t.Errorf("Expected the same board in hand %v, but instead it was %v and %v.", hand, a.community, b.community)
+		}
+		if hand == 0 && clone.Seed() != a.Seed() {
+			t.Errorf("Expected the clone's hand to be shuffled from the same seed as the game's first hand, but instead it was %v rather than %v.",
+				clone.Seed(), a.Seed())
+		}
+		a.DistributePot()
+		b.DistributePot()
+	}
+}
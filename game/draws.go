@@ -0,0 +1,160 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// Labels returned by DrawType.
+const (
+	flushDraw     = "flush draw"
+	openEndedDraw = "open-ended straight draw"
+	gutshotDraw   = "gutshot"
+	comboDraw     = "combo draw"
+)
+
+const (
+	minDrawBoard    = 3 // draws are only possible once the flop has been dealt
+	maxDrawBoard    = 4 // no cards are left to come after the river
+	cardsInStraight = 5
+)
+
+// DrawType labels the draws that the hole cards have with the board: "flush draw",
+// "open-ended straight draw", "gutshot", and "combo draw" when the hand has both a flush draw and a
+// straight draw. If the hand already has a pair or better, its category (Ex. "three of a kind") is
+// reported first so that made hands with a redraw are labelled with both. Draws that come entirely
+// from the board are not reported, and draws are only reported on the flop and turn.
+// A draw to two different straight ranks, such as a double gutshot, is reported as open-ended.
+func DrawType(hole [2]cards.Card, board []cards.Card) []string {
+	all := append([]cards.Card{hole[0], hole[1]}, board...)
+	labels := []string{}
+	category := cards.Category(all)
+	if category > cards.HighCard {
+		labels = append(labels, strings.ToLower(category.String()))
+	}
+	if len(board) < minDrawBoard || len(board) > maxDrawBoard {
+		return labels
+	}
+
+	hasFlushDraw := category < cards.Flush && isFlushDraw(hole, all)
+	if hasFlushDraw {
+		labels = append(labels, flushDraw)
+	}
+	hasStraightDraw := false
+	if category < cards.Straight {
+		boardOuts := straightCompletingRanks(board)
+		outs := 0
+		for _, r := range straightCompletingRanks(all) {
+			if !rankInSlice(r, boardOuts) {
+				outs++
+			}
+		}
+		if outs >= 2 {
+			labels = append(labels, openEndedDraw)
+		} else if outs == 1 {
+			labels = append(labels, gutshotDraw)
+		}
+		hasStraightDraw = outs > 0
+	}
+	if hasFlushDraw && hasStraightDraw {
+		labels = append(labels, comboDraw)
+	}
+	return labels
+}
+
+// isFlushDraw returns true if the cards contain exactly four cards of a suit that matches at least
+// one of the hole cards.
+func isFlushDraw(hole [2]cards.Card, all []cards.Card) bool {
+	for _, h := range hole {
+		count := 0
+		for _, c := range all {
+			if c.Suit() == h.Suit() {
+				count++
+			}
+		}
+		if count == 4 {
+			return true
+		}
+	}
+	return false
+}
+
+// straightCompletingRanks returns the ranks that would complete a straight if one card of that rank
+// was added to the cards. Returns no ranks if the cards already contain a straight.
+func straightCompletingRanks(cs []cards.Card) []cards.Rank {
+	// index 1 represents an ace when it is used as the low card.
+	var present [cards.Ace + 1]bool
+	for _, c := range cs {
+		markRank(&present, c.Rank())
+	}
+	ranks := []cards.Rank{}
+	if containsStraight(present) {
+		return ranks
+	}
+	for r := cards.Two; r <= cards.Ace; r++ {
+		if present[r] {
+			continue
+		}
+		withRank := present
+		markRank(&withRank, r)
+		if containsStraight(withRank) {
+			ranks = append(ranks, r)
+		}
+	}
+	return ranks
+}
+
+func markRank(present *[cards.Ace + 1]bool, r cards.Rank) {
+	present[r] = true
+	if r == cards.Ace {
+		present[1] = true
+	}
+}
+
+// Returns true if there are five consecutive ranks present.
+func containsStraight(present [cards.Ace + 1]bool) bool {
+	count := 0
+	for _, p := range present[1:] {
+		if p {
+			count++
+		} else {
+			count = 0
+		}
+		if count == cardsInStraight {
+			return true
+		}
+	}
+	return false
+}
+
+func rankInSlice(r cards.Rank, s []cards.Rank) bool {
+	for _, rank := range s {
+		if r == rank {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDrawingDead returns true if no way of dealing the rest of the board gives the hole cards a hand at
+// least as good as the opponent's best hand, so the player can't win or split the pot whatever comes.
+// The opponent's hand is treated as fixed, and the cards in it can't be dealt.
+func IsDrawingDead(hole [2]cards.Card, board []cards.Card, opponentBest cards.Hand) bool {
+	known := append(append([]cards.Card{hole[0], hole[1]}, board...), opponentBest...)
+	unseen := cards.NewCardSet(cards.AllCards())
+	for _, c := range known {
+		unseen.Remove(c)
+	}
+	dead := true
+	cards.ForEachCombination(unseen.Cards(), 5-len(board), func(runout []cards.Card) {
+		if !dead {
+			return
+		}
+		hand := append(append([]cards.Card{hole[0], hole[1]}, board...), runout...)
+		if cards.CompareHands(hand, opponentBest) >= 0 {
+			dead = false
+		}
+	})
+	return dead
+}
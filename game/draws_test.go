@@ -0,0 +1,102 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestDrawType(t *testing.T) {
+	tests := []struct {
+		hole     [2]cards.Card
+		board    []cards.Card
+		expected []string
+	}{
+		// Four hearts.
+		{
+			[2]cards.Card{cards.NewCard(cards.Ace, cards.Heart), cards.NewCard(cards.Two, cards.Heart)},
+			[]cards.Card{cards.NewCard(cards.King, cards.Heart), cards.NewCard(cards.Seven, cards.Heart), cards.NewCard(cards.Queen, cards.Club)},
+			[]string{"flush draw"},
+		},
+		// Eight through jack, needs a seven or a queen.
+		{
+			[2]cards.Card{cards.NewCard(cards.Eight, cards.Spade), cards.NewCard(cards.Nine, cards.Heart)},
+			[]cards.Card{cards.NewCard(cards.Ten, cards.Diamond), cards.NewCard(cards.Jack, cards.Club), cards.NewCard(cards.Two, cards.Heart)},
+			[]string{"open-ended straight draw"},
+		},
+		// Needs a nine.
+		{
+			[2]cards.Card{cards.NewCard(cards.Eight, cards.Spade), cards.NewCard(cards.Ten, cards.Heart)},
+			[]cards.Card{cards.NewCard(cards.Jack, cards.Diamond), cards.NewCard(cards.Queen, cards.Club), cards.NewCard(cards.Two, cards.Heart)},
+			[]string{"gutshot"},
+		},
+		{
+			[2]cards.Card{cards.NewCard(cards.Eight, cards.Heart), cards.NewCard(cards.Nine, cards.Heart)},
+			[]cards.Card{cards.NewCard(cards.Ten, cards.Heart), cards.NewCard(cards.Jack, cards.Club), cards.NewCard(cards.Two, cards.Heart)},
+			[]string{"flush draw", "open-ended straight draw", "combo draw"},
+		},
+		// A set with a flush redraw.
+		{
+			[2]cards.Card{cards.NewCard(cards.Seven, cards.Heart), cards.NewCard(cards.Seven, cards.Club)},
+			[]cards.Card{cards.NewCard(cards.Seven, cards.Diamond), cards.NewCard(cards.Jack, cards.Club), cards.NewCard(cards.Two, cards.Club), cards.NewCard(cards.King, cards.Club)},
+			[]string{"three of a kind", "flush draw"},
+		},
+		// The straight draw is entirely on the board.
+		{
+			[2]cards.Card{cards.NewCard(cards.Two, cards.Heart), cards.NewCard(cards.King, cards.Club)},
+			[]cards.Card{cards.NewCard(cards.Five, cards.Diamond), cards.NewCard(cards.Six, cards.Spade), cards.NewCard(cards.Seven, cards.Club), cards.NewCard(cards.Eight, cards.Heart)},
+			[]string{},
+		},
+		// No draws are possible on the river.
+		{
+			[2]cards.Card{cards.NewCard(cards.Ace, cards.Heart), cards.NewCard(cards.Two, cards.Heart)},
+			[]cards.Card{cards.NewCard(cards.King, cards.Heart), cards.NewCard(cards.Seven, cards.Heart), cards.NewCard(cards.Queen, cards.Club), cards.NewCard(cards.Four, cards.Club), cards.NewCard(cards.Nine, cards.Spade)},
+			[]string{},
+		},
+	}
+	for _, test := range tests {
+		labels := DrawType(test.hole, test.board)
+		if !stringsEqual(labels, test.expected) {
+			t.Errorf("Expected DrawType(%v, %v) to return %v, but instead it returned %v.",
+				test.hole,
+				test.board,
+				test.expected,
+				labels)
+		}
+	}
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIsDrawingDead(t *testing.T) {
+	tests := []struct {
+		name     string
+		hole     string
+		board    string
+		opponent string
+		expected bool
+	}{
+		{"trips against a royal flush", "As Ks", "Ah Kh Qh Jh", "Ah Kh Qh Jh Th", true},
+		{"drawing to a royal flush against a set", "Qh Jh", "Ah Kh 7c 2d", "Ah Ad Ac Kh 7c", false},
+		{"no outs against quads on the flop", "8s 9s", "Kc Kd 2h", "Kc Kd Ks Kh 2h", true},
+		{"beaten on the river", "Qc Jc", "Ah Kh 7c 2d 3s", "Ah Ad Kh 7c 3s", true},
+		{"split on the river", "Qc Jc", "Ah Kh Qh Jh Th", "Ah Kh Qh Jh Th", false},
+	}
+	for _, test := range tests {
+		hole := parseCards(test.hole)
+		dead := IsDrawingDead([2]cards.Card{hole[0], hole[1]}, parseCards(test.board), parseCards(test.opponent))
+		if dead != test.expected {
+			t.Errorf("%v: Expected IsDrawingDead to return %v, but instead it returned %v.", test.name, test.expected, dead)
+		}
+	}
+}
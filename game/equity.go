@@ -0,0 +1,191 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// EquityVsRange estimates the share of the pot that the hole cards win against an opponent holding a
+// hand from oppRange by simulating the specified number of deals. Each deal samples an opponent hand
+// uniformly from the range and completes the board with random cards. Hands in the range that share
+// a card with the hole cards or the board are ignored, and ties count as half a win.
+// Returns 0 if iterations is not positive or no hand in the range is possible.
+func EquityVsRange(hole [2]cards.Card, board []cards.Card, oppRange [][2]cards.Card, iterations int) float64 {
+	known := append([]cards.Card{hole[0], hole[1]}, board...)
+	possible := [][2]cards.Card{}
+	for _, h := range oppRange {
+		if h[0] != h[1] && !cardInSlice(h[0], known) && !cardInSlice(h[1], known) {
+			possible = append(possible, h)
+		}
+	}
+	if iterations <= 0 || len(possible) == 0 {
+		return 0
+	}
+
+	r := rand.New(cards.NewTimeSource())
+	total := 0.0
+	for i := 0; i < iterations; i++ {
+		opp := possible[r.Intn(len(possible))]
+		total += simulateShowdown(hole, opp, board, r)
+	}
+	return total / float64(iterations)
+}
+
+// PotOdds returns the share of the final pot that a call contributes, which is the equity needed for
+// the call to break even. The pot size includes any bets already made, but not the call.
+func PotOdds(potSize, callAmount int) float64 {
+	if potSize+callAmount <= 0 {
+		return 0
+	}
+	return float64(callAmount) / float64(potSize+callAmount)
+}
+
+// CurrentPotOdds returns the pot odds of the player whose turn it is, for calling the bet they face with
+// the pot as it is now, including the bets of the current betting round. A call for more than the player
+// has is a call of their whole stack. It returns 0 if the player has no bet to call.
+func (g GameState) CurrentPotOdds() float64 {
+	call := minInt(g.callAmount(g.whoseTurn), g.table[g.whoseTurn].money)
+	if call <= 0 {
+		return 0
+	}
+	return PotOdds(g.potSize(), call)
+}
+
+// ExpectedValue returns the average amount in chips that a call wins or loses, given the share of the
+// pot the caller expects to win. The pot size includes any bets already made, but not the call.
+func ExpectedValue(equity float64, potSize, callAmount int) float64 {
+	return equity*float64(potSize) - (1-equity)*float64(callAmount)
+}
+
+// thinValueShare is the share of the pot that a called bet must win on average to be a value bet rather
+// than a thin value bet.
+const thinValueShare = 0.1
+
+// ClassifyBet describes a bet by the equity the bettor has against the hands that call it. A bet is a
+// "bluff" if it loses on average when called, so it only makes money by getting better hands to fold.
+// Otherwise it is a "value" bet if, when called, it wins on average at least a tenth of the pot size
+// before the bet, or a "thin value" bet if it wins less.
+func ClassifyBet(equity float64, betSize, potSize int) string {
+	// compared to checking, a called bet wins the caller's chips with the bettor's equity and loses the
+	// bettor's chips otherwise
+	gain := (2*equity - 1) * float64(betSize)
+	switch {
+	case gain < 0:
+		return "bluff"
+	case gain < thinValueShare*float64(potSize):
+		return "thin value"
+	default:
+		return "value"
+	}
+}
+
+// winProbabilitySimulations is the number of boards dealt to estimate win probabilities before the flop.
+const winProbabilitySimulations = 20000
+
+// WinProbabilities returns the share of the pot each player still in the round is expected to win given
+// their hole cards and the community cards, with ties counting as an equal share for each player who
+// ties. After the flop every possible way of completing the board is counted exactly, and before the
+// flop the probabilities are estimated from random boards. Hands are compared on each completed board
+// rather than as they stand, so a player who is tied but has outs to improve, a freeroll, gets more
+// than an equal share. It returns an error if fewer than two players are in the round.
+func (g GameState) WinProbabilities() (map[int]float64, error) {
+	if len(g.participating) < 2 {
+		return nil, fmt.Errorf("error computing win probabilities: %v players are in the round, but there must be at least 2",
+			len(g.participating))
+	}
+	dealt := append([]cards.Card{}, g.community...)
+	for _, id := range g.participating {
+		dealt = append(dealt, g.table[id].hand[0], g.table[id].hand[1])
+	}
+	toCome := 5 - len(g.community)
+	shares := make(map[int]float64)
+	boards := 0
+	if toCome > 2 {
+		// sampled from a copy of the game's source, so the game deals the same cards afterwards
+		r := rand.New(g.rng.Copy())
+		for ; boards < winProbabilitySimulations; boards++ {
+			g.awardShares(append(append([]cards.Card{}, g.community...), randomCards(toCome, dealt, r)...), shares)
+		}
+	} else {
+		unseen := cards.NewCardSet(cards.AllCards())
+		for _, c := range dealt {
+			unseen.Remove(c)
+		}
+		for _, runout := range cards.Combinations(unseen.Cards(), toCome) {
+			g.awardShares(append(append([]cards.Card{}, g.community...), runout...), shares)
+			boards++
+		}
+	}
+	probabilities := make(map[int]float64)
+	for _, id := range g.participating {
+		probabilities[id] = shares[id] / float64(boards)
+	}
+	return probabilities, nil
+}
+
+// awardShares splits a share of 1 between the players in the round with the best hand on the board.
+func (g GameState) awardShares(board []cards.Card, shares map[int]float64) {
+	winners := []int{}
+	var best []cards.Card
+	for _, id := range g.participating {
+		hand := append([]cards.Card{g.table[id].hand[0], g.table[id].hand[1]}, board...)
+		if len(winners) == 0 {
+			winners, best = []int{id}, hand
+			continue
+		}
+		switch cards.CompareHands(hand, best) {
+		case 1:
+			winners, best = []int{id}, hand
+		case 0:
+			winners = append(winners, id)
+		}
+	}
+	for _, id := range winners {
+		shares[id] += 1 / float64(len(winners))
+	}
+}
+
+// simulateShowdown completes the board with random cards drawn with r and returns 1 if the hole cards
+// beat the opponent's, 0.5 if they tie, and 0 if they lose.
+func simulateShowdown(hole [2]cards.Card, opp [2]cards.Card, board []cards.Card, r *rand.Rand) float64 {
+	dealt := append([]cards.Card{hole[0], hole[1], opp[0], opp[1]}, board...)
+	fullBoard := append(append([]cards.Card{}, board...), randomCards(5-len(board), dealt, r)...)
+	playerCards := append([]cards.Card{hole[0], hole[1]}, fullBoard...)
+	oppCards := append([]cards.Card{opp[0], opp[1]}, fullBoard...)
+	switch cards.CompareHands(playerCards, oppCards) {
+	case 1:
+		return 1
+	case 0:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// randomCards returns n cards drawn from a deck shuffled with r, none of which are in the excluded cards.
+func randomCards(n int, excluded []cards.Card, r *rand.Rand) []cards.Card {
+	deck := cards.GenerateDeckFrom(r)
+	excludedSet := cards.NewCardSet(excluded)
+	drawn := []cards.Card{}
+	for len(drawn) < n {
+		card, err := deck.Draw()
+		if err != nil {
+			panic(err)
+		}
+		if !excludedSet.Contains(card) {
+			drawn = append(drawn, card)
+		}
+	}
+	return drawn
+}
+
+func cardInSlice(c cards.Card, s []cards.Card) bool {
+	for _, card := range s {
+		if c == card {
+			return true
+		}
+	}
+	return false
+}
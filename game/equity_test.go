@@ -0,0 +1,244 @@
+package game
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestEquityVsRange(t *testing.T) {
+	aces := [2]cards.Card{cards.NewCard(cards.Ace, cards.Heart), cards.NewCard(cards.Ace, cards.Spade)}
+	kings := [][2]cards.Card{
+		{cards.NewCard(cards.King, cards.Heart), cards.NewCard(cards.King, cards.Spade)},
+		{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)},
+	}
+	// Aces are roughly an 82% favourite against kings before the flop.
+	equity := EquityVsRange(aces, []cards.Card{}, kings, 20000)
+	if math.Abs(equity-0.82) > 0.03 {
+		t.Errorf("Expected AA to have about 0.82 equity against KK, but instead it had %v.", equity)
+	}
+}
+
+func TestEquityVsRangeFullBoard(t *testing.T) {
+	aces := [2]cards.Card{cards.NewCard(cards.Ace, cards.Heart), cards.NewCard(cards.Ace, cards.Spade)}
+	board := []cards.Card{
+		cards.NewCard(cards.King, cards.Heart),
+		cards.NewCard(cards.Seven, cards.Club),
+		cards.NewCard(cards.Two, cards.Diamond),
+		cards.NewCard(cards.Nine, cards.Spade),
+		cards.NewCard(cards.Four, cards.Heart),
+	}
+	tests := []struct {
+		oppRange [][2]cards.Card
+		expected float64
+	}{
+		// Kings make a set, the combination containing the king on the board is ignored.
+		{
+			[][2]cards.Card{
+				{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)},
+				{cards.NewCard(cards.King, cards.Heart), cards.NewCard(cards.King, cards.Spade)},
+			},
+			0,
+		},
+		{
+			[][2]cards.Card{{cards.NewCard(cards.Queen, cards.Club), cards.NewCard(cards.Queen, cards.Diamond)}},
+			1,
+		},
+		{
+			[][2]cards.Card{{cards.NewCard(cards.Ace, cards.Club), cards.NewCard(cards.Ace, cards.Diamond)}},
+			0.5,
+		},
+		// No hand in the range is possible since the opponent would need a card on the board.
+		{
+			[][2]cards.Card{{cards.NewCard(cards.King, cards.Heart), cards.NewCard(cards.Queen, cards.Diamond)}},
+			0,
+		},
+	}
+	for _, test := range tests {
+		equity := EquityVsRange(aces, board, test.oppRange, 100)
+		if equity != test.expected {
+			t.Errorf("Expected EquityVsRange against %v to return %v, but instead it returned %v.",
+				test.oppRange,
+				test.expected,
+				equity)
+		}
+	}
+}
+
+func TestPotOdds(t *testing.T) {
+	tests := []struct {
+		potSize    int
+		callAmount int
+		expected   float64
+	}{
+		{100, 50, 1.0 / 3},
+		{100, 100, 0.5},
+		{30, 10, 0.25},
+		{100, 0, 0},
+		{0, 0, 0},
+	}
+	for _, test := range tests {
+		odds := PotOdds(test.potSize, test.callAmount)
+		if math.Abs(odds-test.expected) > 1e-9 {
+			t.Errorf("Expected PotOdds(%v, %v) to return %v, but instead it returned %v.", test.potSize, test.callAmount, test.expected, odds)
+		}
+	}
+}
+
+func TestCurrentPotOdds(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.table[0].money = 10
+	g.newRound()
+	steps := []struct {
+		name     string
+		action   Action
+		expected float64
+	}{
+		// player 2 faces the big blind of 4 with 6 in the pot
+		{"facing the big blind", Action{Type: RaiseAction, Amount: 20}, 4.0 / 10},
+		// the small blind has 8 left and can only call all-in for it, with 2 + 4 + 24 in the pot
+		{"short-stacked call", Action{Type: CallAction}, 8.0 / 38},
+		// the big blind faces 20 more with 24 + 10 + 4 in the pot
+		{"facing a raise", Action{Type: CallAction}, 20.0 / 58},
+	}
+	for _, step := range steps {
+		if odds := g.CurrentPotOdds(); math.Abs(odds-step.expected) > 1e-9 {
+			t.Errorf("%v: Expected pot odds of %v, but instead they were %v.", step.name, step.expected, odds)
+		}
+		if err := g.Apply(g.whoseTurn, step.action); err != nil {
+			t.Fatalf("%v: Expected the action to be valid, but instead got error %v.", step.name, err)
+		}
+	}
+	g.AdvancePhase()
+	if odds := g.CurrentPotOdds(); odds != 0 {
+		t.Errorf("Expected pot odds of 0 with no bet to call, but instead they were %v.", odds)
+	}
+}
+
+// Tests that two players with the same straight on the flop don't split the equity evenly when one of
+// them is also drawing to a flush.
+func TestWinProbabilitiesFreeroll(t *testing.T) {
+	g := allInGame("Ah Kc", "As Kd", "Th Jh Qd")
+	probabilities, err := g.WinProbabilities()
+	if err != nil {
+		t.Fatalf("Expected WinProbabilities not to return an error, but it returned %v.", err)
+	}
+	if probabilities[0] <= 0.5 || probabilities[1] >= 0.5 {
+		t.Errorf("Expected the player with the flush draw to freeroll with more than half the equity, but instead the probabilities were %v.", probabilities)
+	}
+}
+
+func TestClassifyBet(t *testing.T) {
+	tests := []struct {
+		equity   float64
+		betSize  int
+		potSize  int
+		expected string
+	}{
+		{0.8, 50, 100, "value"},
+		{0.2, 50, 100, "bluff"},
+		{0.45, 100, 100, "bluff"},
+		{0.55, 50, 100, "thin value"},
+		{0.55, 200, 100, "value"},
+		{0.5, 50, 100, "thin value"},
+	}
+	for _, test := range tests {
+		if kind := ClassifyBet(test.equity, test.betSize, test.potSize); kind != test.expected {
+			t.Errorf("Expected a bet of %v into %v with %v equity to be %q, but instead it was %q.", test.betSize, test.potSize, test.equity, test.expected, kind)
+		}
+	}
+}
+
+func TestExpectedValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		equity     float64
+		potSize    int
+		callAmount int
+		expected   float64
+	}{
+		// Calling 50 into a pot of 100 needs a third of the pot to break even.
+		{"break-even", 1.0 / 3, 100, 50, 0},
+		{"+EV", 0.5, 100, 50, 25},
+		{"-EV", 0.2, 100, 50, -20},
+		{"free call", 0.1, 100, 0, 10},
+	}
+	for _, test := range tests {
+		ev := ExpectedValue(test.equity, test.potSize, test.callAmount)
+		if math.Abs(ev-test.expected) > 1e-9 {
+			t.Errorf("%v: Expected ExpectedValue(%v, %v, %v) to return %v, but instead it returned %v.",
+				test.name, test.equity, test.potSize, test.callAmount, test.expected, ev)
+		}
+	}
+}
+
+// Returns a game in which players 0 and 1 are all-in with the specified hole cards and board.
+func allInGame(hole0, hole1 string, board string) GameState {
+	g, _ := NewGame(3, 100, 4)
+	g.participating = []int{0, 1}
+	for id, notation := range []string{hole0, hole1} {
+		parsed := parseCards(notation)
+		g.table[id].hand = [2]cards.Card{parsed[0], parsed[1]}
+	}
+	g.community = parseCards(board)
+	return g
+}
+
+// Returns the cards in the space separated notation, ignoring errors. Ex. "Ah Kd"
+func parseCards(notation string) []cards.Card {
+	parsed, _ := cards.ParseCards(notation)
+	return parsed
+}
+
+func TestWinProbabilities(t *testing.T) {
+	tests := []struct {
+		name      string
+		hole0     string
+		hole1     string
+		board     string
+		expected0 float64
+		tolerance float64
+	}{
+		// Aces are roughly an 82% favourite against kings before the flop.
+		{"preflop", "Ah As", "Kh Ks", "", 0.82, 0.02},
+		// Kings can only win with one of the two remaining kings on the river.
+		{"turn", "Ah As", "Kh Ks", "2c 7d 9s Jh", 42.0 / 44, 1e-9},
+		{"river", "Ah As", "Kh Ks", "2c 7d 9s Jh Kd", 0, 0},
+		{"board plays", "2h 3s", "4h 5s", "Tc Jd Qs Kh Ac", 0.5, 0},
+		// Both players have the same straight, and player 0 also wins with any of the 9 hearts left.
+		{"freeroll", "Ah Kc", "As Kd", "Th Jh Qd 2h", 9.0/44 + 35.0/44/2, 1e-9},
+	}
+	for _, test := range tests {
+		g := allInGame(test.hole0, test.hole1, test.board)
+		probabilities, err := g.WinProbabilities()
+		if err != nil {
+			t.Fatalf("%v: Expected WinProbabilities not to return an error, but it returned %v.", test.name, err)
+		}
+		if math.Abs(probabilities[0]-test.expected0) > test.tolerance {
+			t.Errorf("%v: Expected player 0 to win with probability %v, but instead it was %v.", test.name, test.expected0, probabilities[0])
+		}
+		if math.Abs(probabilities[0]+probabilities[1]-1) > 1e-9 {
+			t.Errorf("%v: Expected the probabilities to add up to 1, but instead they were %v.", test.name, probabilities)
+		}
+	}
+}
+
+func TestWinProbabilitiesThreeWay(t *testing.T) {
+	g := allInGame("Ah As", "Kh Ks", "2c 7d 9s")
+	g.participating = []int{0, 1, 2}
+	g.table[2].hand = [2]cards.Card{parseCards("Qc")[0], parseCards("Qd")[0]}
+	probabilities, _ := g.WinProbabilities()
+	total := 0.0
+	for _, p := range probabilities {
+		total += p
+	}
+	if len(probabilities) != 3 || math.Abs(total-1) > 1e-9 || probabilities[0] < probabilities[1] {
+		t.Errorf("Expected three probabilities adding up to 1 with aces ahead, but instead they were %v.", probabilities)
+	}
+
+	g.participating = []int{0}
+	if _, err := g.WinProbabilities(); err == nil {
+		t.Errorf("Expected an error computing win probabilities with one player in the round, but there wasn't one.")
+	}
+}
@@ -3,6 +3,7 @@ package game
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/Chris-Behan/gopoker/cards"
 )
@@ -11,60 +12,405 @@ type player struct {
 	id               int // id of the player which is the same as where they are seated at the table
 	hand             [2]cards.Card
 	money            int
-	alive            bool // whether or not the player is still in the game
-	amountBetInRound int  // amount the player has bet in the current round
+	alive            bool    // whether or not the player is still in the game
+	amountBetInRound int     // amount the player has bet in the current round
+	actedInRound     bool    // whether or not the player has checked, called, bet, or raised in the current round
+	shown            [2]bool // which of the player's hole cards they have shown to the table
+	waitingForBB     bool    // whether the player joined without posting and sits out until the big blind reaches them
+	postingBlind     bool    // whether the player joined by posting a big blind, which they post in the next hand
+	canRaise         bool    // whether the player can raise this round, until they act and again after someone else's full bet or raise
 }
 
-type gamePhase int8
+// Phase is a stage of a round, named after the betting round being played or the showdown.
+type Phase int8
 
 const (
-	preFlop  gamePhase = 0
-	flop     gamePhase = 1
-	turn     gamePhase = 2
-	river    gamePhase = 3
-	showdown gamePhase = 4
+	PreFlop  Phase = 0
+	Flop     Phase = 1
+	Turn     Phase = 2
+	River    Phase = 3
+	Showdown Phase = 4
 )
 
+var phaseNames = map[Phase]string{
+	PreFlop:  "preflop",
+	Flop:     "flop",
+	Turn:     "turn",
+	River:    "river",
+	Showdown: "showdown",
+}
+
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("Phase(%d)", int8(p))
+}
+
+// ShowdownMode determines how the pot is awarded at showdown.
+type ShowdownMode int8
+
+const (
+	// HighOnly awards the pot to the best high hand.
+	HighOnly ShowdownMode = 0
+	// HiLo splits the pot between the best high hand and the best eight-or-better low hand, awarding
+	// the whole pot to the best high hand if no low hand qualifies.
+	HiLo ShowdownMode = 1
+)
+
+// GameMode is the kind of game being played, which determines whether pots are raked and whether
+// players can rebuy.
+type GameMode int8
+
+const (
+	// CashGame rakes pots according to the rules and lets players rebuy between hands.
+	CashGame GameMode = 0
+	// TournamentGame never rakes pots, since tournaments take their fees when players enter, and players
+	// who run out of money are eliminated for good.
+	TournamentGame GameMode = 1
+)
+
+// BettingStructure limits the amounts that players can bet and raise.
+type BettingStructure int8
+
+const (
+	// NoLimit allows bets and raises of any amount up to the player's stack.
+	NoLimit BettingStructure = 0
+	// PotLimit allows bets and raises up to the size of the pot, including the amount needed to call.
+	PotLimit BettingStructure = 1
+	// FixedLimit requires bets and raises to be exactly one big blind preflop and on the flop, and
+	// two big blinds on the turn and river.
+	FixedLimit BettingStructure = 2
+)
+
+// Rules configures optional variations to how a game is played. The zero value plays standard Texas Hold'em.
+type Rules struct {
+	ShowdownMode ShowdownMode
+	Betting      BettingStructure
+	// AllowOutOfTurnFold lets players fold before the action reaches them, removing them from the round immediately.
+	AllowOutOfTurnFold bool
+	// DeadButton moves the big blind to the next player each round, with the small blind and button
+	// following where the big and small blinds were, so no player skips a blind when someone is
+	// eliminated. The button may be left on an eliminated player's seat, and the small blind isn't
+	// posted when it falls to an eliminated player.
+	DeadButton bool
+	// MinimumBets overrides the minimum bet on the specified streets, which is otherwise the big blind.
+	// In FixedLimit it overrides the fixed size of bets and raises on those streets.
+	MinimumBets map[Phase]int
+	// BadBeatThreshold is the weakest hand that counts as a bad beat when it loses at showdown. Ex. a
+	// hand of three aces and two twos makes aces full or better qualify. Bad beats aren't detected if
+	// it is empty.
+	BadBeatThreshold cards.Hand
+	// Mode is whether the game is a cash game or part of a tournament.
+	Mode GameMode
+	// RakeRate is the fraction of each pot taken as rake in a cash game, Ex. 0.05 for 5%. The rake is
+	// rounded down to a whole dollar.
+	RakeRate float64
+	// RakeCap is the most rake taken from a single pot, 0 for no cap.
+	RakeCap int
+	// TableSize is the most seats the table can have, Ex. 6 or 9 for short-handed and full ring tables.
+	// It can't be more than MaxPlayers, which is the table size if it is 0.
+	TableSize int
+	// SmallBlind is the amount of the small blind, which must be less than the big blind. If it is 0 the
+	// small blind is half the big blind, which must then be even so that it isn't rounded down.
+	SmallBlind int
+	// ActionOrder decides who acts first on each street, for variants that don't follow the order of
+	// Hold'em. HoldemActionOrder is used if it is nil.
+	ActionOrder ActionOrder
+}
+
+// ActionOrder returns the id of the player who acts first on the current street of the game. It is
+// called once the street's cards have been dealt, and must return a player who is still in the round,
+// otherwise the action starts as it would in Hold'em.
+type ActionOrder func(g GameState) int
+
+// HoldemActionOrder is the order of action in Hold'em. Before the flop the first player left of the big
+// blind acts first, and after the flop the first player left of the button does.
+func HoldemActionOrder(g GameState) int {
+	if g.phase == PreFlop {
+		return g.participantClockwiseToPlayer(g.bigBlindPos)
+	}
+	return g.firstToActAfterFlop()
+}
+
+// Returns an error if the rules can't be played by a game: if the showdown mode or betting structure is
+// unknown, the table size is more than MaxPlayers, or a minimum bet isn't positive or isn't for a
+// betting round.
+func (r Rules) validate() error {
+	if r.ShowdownMode != HighOnly && r.ShowdownMode != HiLo {
+		return fmt.Errorf("unknown showdown mode %v", r.ShowdownMode)
+	}
+	if r.Betting != NoLimit && r.Betting != PotLimit && r.Betting != FixedLimit {
+		return fmt.Errorf("unknown betting structure %v", r.Betting)
+	}
+	if r.TableSize < 0 || r.TableSize > MaxPlayers {
+		return fmt.Errorf("table size must be between 0 and %v, not %v", MaxPlayers, r.TableSize)
+	}
+	for phase, amount := range r.MinimumBets {
+		if phase < PreFlop || phase > River || amount <= 0 {
+			return fmt.Errorf("the minimum bet on the %v must be at least $1 on a betting round, not $%v", phase, amount)
+		}
+	}
+	return nil
+}
+
+// Returns the small blind played with the rules and the big blind, or an error if the rules' small blind
+// isn't between $1 and the big blind, or if it is 0 and the big blind can't be halved into a whole small blind.
+func (r Rules) smallBlindFor(bigBlindAmt int) (int, error) {
+	if r.SmallBlind == 0 {
+		if bigBlindAmt < 2 || bigBlindAmt%2 != 0 {
+			return 0, fmt.Errorf("the small blind must be set for a big blind of $%v, which can't be halved evenly", bigBlindAmt)
+		}
+		return bigBlindAmt / 2, nil
+	}
+	if r.SmallBlind < 0 || r.SmallBlind >= bigBlindAmt {
+		return 0, fmt.Errorf("the small blind must be between $1 and the big blind of $%v, not $%v", bigBlindAmt, r.SmallBlind)
+	}
+	return r.SmallBlind, nil
+}
+
+// Returns the most seats a table played with the rules can have.
+func (r Rules) maxSeats() int {
+	if r.TableSize == 0 {
+		return MaxPlayers
+	}
+	return r.TableSize
+}
+
 type GameState struct {
 	table             []player // players playing at the table
 	bigBlindAmount    int
 	smallBlindAmount  int
+	buttonPos         int // index of table where the dealer button is
 	bigBlindPos       int // index of table where the big blind is
 	smallBlindPos     int // index of table where the small blind is
-	pot               int // Amount of money in the pot
+	pot               Pot // money put in the pot this hand and who it came from
 	highestBetInRound int // Highest betting amount of the current round
 	whoseTurn         int // id of the player whose turn it is
-	phase             gamePhase
-	participating     []int // id of players participating in the round
-	betInCurrentRound bool  // whether or not there has been a bet in the current round (round being preflop, flop, turn, etc)
+	phase             Phase
+	participating     []int        // id of players participating in the round
+	betInCurrentRound bool         // whether or not there has been a bet in the current round (round being preflop, flop, turn, etc)
+	deck              cards.Deck   // deck the current round is dealt from
+	community         []cards.Card // community cards dealt in the current round
+	rules             Rules
+	handsPlayed       int           // number of rounds that have been started
+	seed              int64         // seed the current round's deck was shuffled with
+	potByStreet       map[Phase]int // size of the pot at the end of each street of the current round
+	observers         []observer    // observers sent a view of the game whenever it changes
+	lastFullRaise     int           // size of the last bet or full raise in the current betting round, the smallest amount the next raise can be
+	announcedTotal    int           // total bet announced with AnnounceRaise by the player whose turn it is, 0 if nothing was announced
+	rng               *cards.Source // source of the seed each round's deck is shuffled with, clones of the game get a copy
+	history           handHistory   // blinds, actions, and results of the current round
+	clock             blindClock    // blind schedule the blinds increase on, if the game has one
+	rotation          rotation      // rule sets a mixed game switches between, if the game is one
+}
+
+// MaxPlayers is the most players a game can have. Each player is dealt two cards from a 52 card deck,
+// which must also have enough left for the five community cards and the three cards burned before them.
+const MaxPlayers = (52 - 5 - 3) / 2
+
+func NewGame(numPlayers int, playerCash int, bigBlindAmt int) (GameState, error) {
+	return NewGameWithRules(numPlayers, playerCash, bigBlindAmt, Rules{}, nil)
+}
+
+// NewGameWithBlinds creates a game like NewGame with the specified small blind, rather than half the big
+// blind. It returns an error if the small blind isn't between $1 and the big blind.
+func NewGameWithBlinds(numPlayers int, playerCash int, smallBlindAmt int, bigBlindAmt int) (GameState, error) {
+	if smallBlindAmt <= 0 {
+		return GameState{}, fmt.Errorf("error creating game: the small blind must be between $1 and the big blind of $%v, not $%v", bigBlindAmt, smallBlindAmt)
+	}
+	return NewGameWithRules(numPlayers, playerCash, bigBlindAmt, Rules{SmallBlind: smallBlindAmt}, nil)
 }
 
-func NewGame(numPlayers int, playerCash int, bigBlindAmt int) GameState {
-	game := GameState{[]player{}, bigBlindAmt, bigBlindAmt / 2, 1, 0, 0, 0, 0, preFlop, []int{}, false}
+// NewGameWithRules creates a game that is played according to the specified rules. Each round's deck is
+// shuffled from a seed drawn from a copy of src, so games created with sources in the same state deal
+// the same cards, or from a source seeded with the current time if src is nil. It returns an error
+// if the rules can't be played, such as an unknown betting structure or a table size more than
+// MaxPlayers, if there are fewer than two players or more than the table size, or if the rules' small
+// blind is negative or isn't less than the big blind, or is 0 and the big blind is odd or less than $2.
+func NewGameWithRules(numPlayers int, playerCash int, bigBlindAmt int, rules Rules, src *cards.Source) (GameState, error) {
+	if err := rules.validate(); err != nil {
+		return GameState{}, fmt.Errorf("error creating game: %v", err)
+	}
+	if maxSeats := rules.maxSeats(); numPlayers < 2 || numPlayers > maxSeats {
+		return GameState{}, fmt.Errorf("error creating game: must have between 2 and %v players, not %v", maxSeats, numPlayers)
+	}
+	smallBlindAmt, err := rules.smallBlindFor(bigBlindAmt)
+	if err != nil {
+		return GameState{}, fmt.Errorf("error creating game: %v", err)
+	}
+	// heads-up the button is the small blind, otherwise it sits to the right of the small blind
+	buttonPos := numPlayers - 1
+	if numPlayers == 2 {
+		buttonPos = 0
+	}
+	if src == nil {
+		src = cards.NewTimeSource()
+	}
+	game := GameState{[]player{}, bigBlindAmt, smallBlindAmt, buttonPos, 1, 0, NewPot(), 0, 0, PreFlop, []int{}, false, cards.Deck{}, []cards.Card{}, rules, 0, 0, map[Phase]int{}, []observer{}, 0, 0, src.Copy(), handHistory{}, blindClock{}, rotation{}}
 	for i := 0; i < numPlayers; i++ {
-		p := player{i, [2]cards.Card{}, playerCash, true, 0}
+		p := player{i, [2]cards.Card{}, playerCash, true, 0, false, [2]bool{}, false, false, false}
 		game.table = append(game.table, p)
 	}
 
-	return game
+	return game, nil
 }
 
 func gameLoop() {
 
 }
 
+// StartHand starts the next hand by shuffling a new deck, dealing the hole cards, and posting the blinds.
+// The button moves to the next player for every hand after the first.
+func (g *GameState) StartHand() {
+	g.newRound()
+}
+
 func (g *GameState) newRound() {
-	g.phase = preFlop
+	g.newRoundWithSeed(g.rng.Int63())
+}
+
+// Starts a new round with the deck shuffled from the specified seed, so that the same seed and actions
+// reproduce the same round. Players who have run out of money are out of the game until they rebuy.
+func (g *GameState) newRoundWithSeed(seed int64) {
+	for i := range g.table {
+		if g.table[i].money == 0 {
+			g.table[i].alive = false
+		}
+	}
+	g.phase = PreFlop
+	g.seed = seed
+	g.deck, _ = cards.GenerateDeckCommitted(seed)
+	g.community = []cards.Card{}
+	g.pot = NewPot()
+	g.potByStreet = map[Phase]int{}
+	for i := range g.table {
+		g.table[i].shown = [2]bool{}
+	}
+	g.applyRotation()
+	g.resetBettingRound()
+	if g.handsPlayed > 0 {
+		g.moveButton()
+	}
+	g.table[g.bigBlindPos].waitingForBB = false
+	g.handsPlayed++
 	g.addAllPlayers()
+	g.history = handHistory{stacks: make(map[int]int)}
+	for _, id := range g.participating {
+		g.history.stacks[id] = g.table[id].money
+	}
 	g.dealCards()
+	g.applyBlindLevel()
 	g.handleBlinds()
-	g.whoseTurn = g.participantClockwiseToPlayer(g.bigBlindPos)
+	g.whoseTurn = g.firstToAct()
+	g.notifyObservers()
+}
+
+// Seed returns the seed that the current round's deck was shuffled with.
+func (g GameState) Seed() int64 {
+	return g.seed
 }
 
-// Adds all players to the GameState.participating slice.
+// Mode returns whether the game is a cash game or part of a tournament.
+func (g GameState) Mode() GameMode {
+	return g.rules.Mode
+}
+
+// Rebuy adds money to the specified player's stack in a cash game, bringing them back into the game if
+// they had run out of money. It returns an error in a tournament, where players can't rebuy, if the amount
+// isn't positive, or if the player is in a hand that is still being played.
+func (g *GameState) Rebuy(playerID int, amount int) error {
+	if g.rules.Mode == TournamentGame {
+		return errors.New("error rebuying: players can't rebuy in a tournament")
+	}
+	if g.getTablePos(playerID) == -1 {
+		return fmt.Errorf("error rebuying: there is no player with id %v", playerID)
+	}
+	if amount <= 0 {
+		return fmt.Errorf("error rebuying: must rebuy for at least $1, not $%v", amount)
+	}
+	if g.phase != Showdown && intInSlice(playerID, g.participating) {
+		return fmt.Errorf("error rebuying: player %v is in a hand that is still being played", playerID)
+	}
+	g.table[playerID].money += amount
+	g.table[playerID].alive = true
+	return nil
+}
+
+// Returns true if a hand has been started and hasn't reached the showdown.
+func (g GameState) handInProgress() bool {
+	return g.handsPlayed > 0 && g.phase != Showdown
+}
+
+// ButtonPosition returns the index of the table where the dealer button is.
+func (g GameState) ButtonPosition() int {
+	return g.buttonPos
+}
+
+// Moves the dealer button to the next player still in the game and places the blinds after it.
+// Heads-up the button posts the small blind.
+func (g *GameState) moveButton() {
+	if g.rules.DeadButton {
+		g.moveDeadButton()
+		return
+	}
+	g.buttonPos = g.aliveClockwiseToPlayer(g.buttonPos)
+	if len(g.alivePlayers()) == 2 {
+		g.smallBlindPos = g.buttonPos
+	} else {
+		g.smallBlindPos = g.aliveClockwiseToPlayer(g.buttonPos)
+	}
+	g.bigBlindPos = g.aliveClockwiseToPlayer(g.smallBlindPos)
+}
+
+// Moves the big blind to the next player still in the game, with the small blind and button taking
+// the previous big and small blind positions, even if those players have been eliminated. Heads-up the
+// button posts the small blind, so the button moves to the player who isn't the big blind.
+func (g *GameState) moveDeadButton() {
+	if len(g.alivePlayers()) == 2 {
+		g.bigBlindPos = g.aliveClockwiseToPlayer(g.bigBlindPos)
+		g.smallBlindPos = g.aliveClockwiseToPlayer(g.bigBlindPos)
+		g.buttonPos = g.smallBlindPos
+		return
+	}
+	g.buttonPos = g.smallBlindPos
+	g.smallBlindPos = g.bigBlindPos
+	g.bigBlindPos = g.aliveClockwiseToPlayer(g.bigBlindPos)
+}
+
+// Returns the id of the first player clockwise to the player ID provided who is still in the game.
+func (g GameState) aliveClockwiseToPlayer(playerID int) int {
+	id := g.getClockwisePlayerID(playerID)
+	for !g.table[id].alive && id != playerID {
+		id = g.getClockwisePlayerID(id)
+	}
+	return id
+}
+
+// ActivePlayerCount returns the number of players who are still in the current round, that is, who
+// haven't folded.
+func (g GameState) ActivePlayerCount() int {
+	return len(g.participating)
+}
+
+// AlivePlayerCount returns the number of players who are still in the game.
+func (g GameState) AlivePlayerCount() int {
+	return len(g.alivePlayers())
+}
+
+// CanAct returns true if the player is still participating in the round and has chips left to act with,
+// and false if they have folded, are all-in, or don't exist.
+func (g GameState) CanAct(playerID int) bool {
+	return intInSlice(playerID, g.participating) && g.table[playerID].money > 0
+}
+
+// Adds all players who are in the game and not waiting for the big blind to the GameState.participating slice.
 func (g *GameState) addAllPlayers() {
 	ids := []int{}
 	for _, p := range g.alivePlayers() {
+		if p.waitingForBB {
+			continue
+		}
 		ids = append(ids, p.id)
 	}
 	g.participating = ids
@@ -85,14 +431,13 @@ func (g *GameState) alivePlayers() []player {
 // and that ONLY alive players are in the participating slice.
 func (g *GameState) dealCards() {
 	playerIDs := g.participating
-	deck := cards.GenerateDeck()
 	numPlayers := len(playerIDs)
 	cardsDealt := 0
 	cardsToDeal := numPlayers * 2
 	playerIdx := 0
 	for cardsDealt < cardsToDeal {
 		playerToDealTo := playerIDs[playerIdx]
-		card, err := deck.Draw()
+		card, err := g.deck.Draw()
 		if err != nil {
 			panic(err)
 		}
@@ -111,11 +456,219 @@ func (g *GameState) dealCards() {
 }
 
 func (g *GameState) handleBlinds() {
-	// deduct blinds from players and add to pot
-	g.table[g.smallBlindPos].money -= g.smallBlindAmount
-	g.pot += g.smallBlindAmount
-	g.table[g.bigBlindPos].money -= g.bigBlindAmount
-	g.pot += g.bigBlindAmount
+	// deduct blinds from players, blinds are the opening bets of the preflop round.
+	// The small blind is dead if it fell to an eliminated player or one waiting for the big blind.
+	if g.table[g.smallBlindPos].alive && !g.table[g.smallBlindPos].waitingForBB {
+		g.postBlind(g.smallBlindPos, g.smallBlindAmount)
+	}
+	g.postBlind(g.bigBlindPos, g.bigBlindAmount)
+	// players who joined by posting put in a big blind unless they are already in the blinds
+	for i := range g.table {
+		if !g.table[i].postingBlind {
+			continue
+		}
+		g.table[i].postingBlind = false
+		if i != g.smallBlindPos && i != g.bigBlindPos {
+			g.postBlind(i, g.bigBlindAmount)
+		}
+	}
+	g.highestBetInRound = g.bigBlindAmount
+	g.lastFullRaise = g.bigBlindAmount
+	g.betInCurrentRound = true
+}
+
+// Posts a blind for the player. A player who can't afford the full blind posts what they have and is
+// all-in, but the other players must still call the full big blind.
+func (g *GameState) postBlind(playerID int, amount int) {
+	amount = minInt(amount, g.table[playerID].money)
+	g.table[playerID].money -= amount
+	g.table[playerID].amountBetInRound += amount
+	g.logAction(playerID, "post", amount)
+}
+
+// AdvancePhase moves the round to its next phase, dealing the flop, turn, or river, or moving to the
+// showdown after the river. The bets of the previous phase are collected into the pot, its betting
+// state is reset, and the action starts with the player picked by the rules' action order, who in
+// Hold'em is the first participating player left of the button. The size of the pot at the end of the
+// previous phase is recorded for PotByStreet.
+func (g *GameState) AdvancePhase() error {
+	switch g.phase {
+	case PreFlop:
+		g.dealCommunityCards(3)
+	case Flop, Turn:
+		g.dealCommunityCards(1)
+	case River:
+		// no cards are dealt for the showdown
+	default:
+		return fmt.Errorf("error advancing phase: cannot advance past phase %v", g.phase)
+	}
+	g.collectBets()
+	g.potByStreet[g.phase] = g.pot.Total()
+	g.phase++
+	g.resetBettingRound()
+	g.whoseTurn = g.firstToAct()
+	g.notifyObservers()
+	return nil
+}
+
+// RevealFlop deals the flop and starts its betting round, the same as AdvancePhase before the flop, so
+// that clients can reveal each street explicitly. Observers are sent the board once it is dealt. It
+// returns an error if the round isn't preflop.
+func (g *GameState) RevealFlop() error {
+	return g.revealStreet(PreFlop, "flop")
+}
+
+// RevealTurn deals the turn and starts its betting round, the same as AdvancePhase on the flop.
+// Observers are sent the board once it is dealt. It returns an error if the round isn't on the flop.
+func (g *GameState) RevealTurn() error {
+	return g.revealStreet(Flop, "turn")
+}
+
+// RevealRiver deals the river and starts its betting round, the same as AdvancePhase on the turn.
+// Observers are sent the board once it is dealt. It returns an error if the round isn't on the turn.
+func (g *GameState) RevealRiver() error {
+	return g.revealStreet(Turn, "river")
+}
+
+// Advances the round to the next street if it is in the specified phase.
+func (g *GameState) revealStreet(from Phase, street string) error {
+	if g.phase != from {
+		return fmt.Errorf("error revealing the %v: the round is in phase %v, not %v", street, g.phase, from)
+	}
+	return g.AdvancePhase()
+}
+
+// SetBoard replaces the community cards with the cards in the notation of cards.ParseCards (Ex. "Ah Kd
+// 7c"), and moves the round to the matching phase. The cards are removed from the deck, so a card that
+// has already been dealt to a player can't be used. Community cards that are replaced are discarded.
+// The board must be empty or have three, four, or five cards.
+func (g *GameState) SetBoard(notation string) error {
+	board, err := cards.ParseCards(notation)
+	if err != nil {
+		return fmt.Errorf("error setting board: %v", err)
+	}
+	phase, ok := boardPhases[len(board)]
+	if !ok {
+		return fmt.Errorf("error setting board: a board cannot have %v cards", len(board))
+	}
+
+	deck := g.deck.Clone()
+	for _, card := range board {
+		if cardInSlice(card, g.community) {
+			continue
+		}
+		if err := deck.Remove(card); err != nil {
+			return fmt.Errorf("error setting board: %v", err)
+		}
+	}
+	g.deck = deck
+	g.community = board
+	g.phase = phase
+	return nil
+}
+
+// DealCommunity burns a card and deals the specified number of community cards from the deck without
+// changing the phase or the betting, for setting up boards such as a flop or a turn in tests. It returns
+// an error if n isn't positive, if the board would have more than five cards, or if the deck doesn't have
+// enough cards left.
+func (g *GameState) DealCommunity(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("error dealing community cards: must deal at least 1 card, not %v", n)
+	}
+	if len(g.community)+n > 5 {
+		return fmt.Errorf("error dealing community cards: a board of %v cards cannot have %v more", len(g.community), n)
+	}
+	if g.deck.Remaining() < n+1 {
+		return fmt.Errorf("error dealing community cards: the deck has %v cards left, not enough to burn one and deal %v", g.deck.Remaining(), n)
+	}
+	g.dealCommunityCards(n)
+	return nil
+}
+
+// PotByStreet returns the size of the pot at the end of each street of the current round that has
+// finished, including the street the hand ended on if the pot has been distributed.
+func (g GameState) PotByStreet() map[Phase]int {
+	pots := make(map[Phase]int)
+	for phase, amount := range g.potByStreet {
+		pots[phase] = amount
+	}
+	return pots
+}
+
+// Phase of the round for each possible number of community cards.
+var boardPhases = map[int]Phase{0: PreFlop, 3: Flop, 4: Turn, 5: River}
+
+// Burns a card and then deals the specified number of community cards.
+func (g *GameState) dealCommunityCards(n int) {
+	if _, err := g.deck.Draw(); err != nil {
+		panic(err)
+	}
+	for i := 0; i < n; i++ {
+		card, err := g.deck.Draw()
+		if err != nil {
+			panic(err)
+		}
+		g.community = append(g.community, card)
+	}
+}
+
+// Moves the amounts bet in the current betting round into the pot, recording who they came from so
+// that side pots can be formed, and resets each player's bet for the round.
+func (g *GameState) collectBets() {
+	for i, p := range g.table {
+		if p.amountBetInRound > 0 {
+			g.pot.Add(p.id, p.amountBetInRound)
+			g.table[i].amountBetInRound = 0
+		}
+	}
+}
+
+// Returns the size of the pot including the bets of the current betting round that haven't been
+// collected yet.
+func (g GameState) potSize() int {
+	total := g.pot.Total()
+	for _, p := range g.table {
+		total += p.amountBetInRound
+	}
+	return total
+}
+
+// Resets the amounts bet in the current betting round so that a new round of betting can start.
+func (g *GameState) resetBettingRound() {
+	for i := range g.table {
+		g.table[i].amountBetInRound = 0
+		g.table[i].actedInRound = false
+		g.table[i].canRaise = true
+	}
+	g.highestBetInRound = 0
+	g.betInCurrentRound = false
+	g.lastFullRaise = 0
+	g.announcedTotal = 0
+}
+
+// Lets every player other than the one who made a full bet or raise raise again, since the betting has
+// been reopened for them.
+func (g *GameState) reopenBetting(playerID int) {
+	for i := range g.table {
+		g.table[i].canRaise = i != playerID
+	}
+}
+
+// Returns the id of the player who acts first on the current street according to the rules' action order.
+func (g GameState) firstToAct() int {
+	if g.rules.ActionOrder == nil {
+		return HoldemActionOrder(g)
+	}
+	if id := g.rules.ActionOrder(g); intInSlice(id, g.participating) {
+		return id
+	}
+	return HoldemActionOrder(g)
+}
+
+// Returns the id of the first participating player left of the button, who is the first to act
+// after the flop. Heads-up this is the big blind, since the button posts the small blind.
+func (g GameState) firstToActAfterFlop() int {
+	return g.participantClockwiseToPlayer(g.buttonPos)
 }
 
 // Returns the next participating player clockwise to the specified player.
@@ -138,27 +691,63 @@ func (g GameState) getClockwisePlayerID(from int) int {
 	return from + 1
 }
 
+// Clone returns a deep copy of the game state, which can be modified without affecting the original.
+// The clone shuffles from a copy of the game's source, so it deals the same cards as the game would.
+// Observers aren't copied, so changes to the clone aren't sent to them.
+func (g GameState) Clone() GameState {
+	clone := g
+	clone.table = append([]player{}, g.table...)
+	clone.participating = append([]int{}, g.participating...)
+	clone.community = append([]cards.Card{}, g.community...)
+	clone.deck = g.deck.Clone()
+	clone.pot = g.pot.clone()
+	clone.potByStreet = g.PotByStreet()
+	clone.observers = []observer{}
+	clone.history = g.history.clone()
+	clone.rng = g.rng.Copy()
+	return clone
+}
+
 // Check checks for the specified player or returns an error if the player cannot check.
 func (g *GameState) Check(playerID int) error {
 	err := g.validateCheck(playerID)
 	if err != nil {
 		return fmt.Errorf("error checking: %v", err)
 	}
-	// handle turn end
+	g.table[playerID].actedInRound = true
+	g.table[playerID].canRaise = false
+	g.logAction(playerID, CheckAction.String(), 0)
+
+	g.whoseTurn = g.getNextPlayersTurn()
+	g.notifyObservers()
 	return nil
 }
 
-// Fold removes the specified player from the current round.
+// Fold removes the specified player from the current round. Players can only fold when it is their
+// turn unless the game's rules allow folding out of turn.
 func (g *GameState) Fold(playerID int) error {
-	if playerID != g.whoseTurn {
+	if err := g.validateHandNotOver(); err != nil {
+		return fmt.Errorf("error folding for player %v: %v", playerID, err)
+	}
+	if playerID != g.whoseTurn && !g.rules.AllowOutOfTurnFold {
 		return fmt.Errorf("error folding player %v because it is player %v's turn", playerID, g.whoseTurn)
 	}
+	// the next player must be found while the folding player is still participating
+	nextTurn := g.whoseTurn
+	if playerID == g.whoseTurn {
+		nextTurn = g.getNextPlayersTurn()
+	}
 	newParticipating, err := removeIntFromSlice(g.participating, playerID)
 	if err != nil {
 		return fmt.Errorf("error folding for player %v: %v", playerID, err)
 	}
 	g.participating = newParticipating
-	// handle turn end
+	g.logAction(playerID, FoldAction.String(), 0)
+	if playerID == g.whoseTurn {
+		g.announcedTotal = 0
+	}
+	g.whoseTurn = nextTurn
+	g.notifyObservers()
 	return nil
 }
 
@@ -171,52 +760,229 @@ func (g *GameState) Bet(playerID int, amount int) error {
 
 	g.table[playerID].money -= amount
 	g.table[playerID].amountBetInRound += amount
-	g.pot += amount
 	g.betInCurrentRound = true
 	g.highestBetInRound = amount
+	g.lastFullRaise = amount
+	g.announcedTotal = 0
+	g.table[playerID].actedInRound = true
+	g.reopenBetting(playerID)
+	g.logAction(playerID, BetAction.String(), amount)
 
 	g.whoseTurn = g.getNextPlayersTurn()
-	// handle turn end
+	g.notifyObservers()
 	return nil
 }
 
-// Call matches the current bet.
+// Call matches the current bet. A player who doesn't have enough money to match it calls all-in for
+// the rest of their stack, and the amount they couldn't match goes to a side pot for the other players.
 func (g *GameState) Call(playerID int) error {
 	err := g.validateCall(playerID)
 	if err != nil {
 		return fmt.Errorf("error calling: %v", err)
 	}
 
-	callAmount := g.callAmount(playerID)
+	callAmount := minInt(g.callAmount(playerID), g.table[playerID].money)
 	g.table[playerID].money -= callAmount
 	g.table[playerID].amountBetInRound += callAmount
-	g.pot += callAmount
+	g.table[playerID].actedInRound = true
+	g.table[playerID].canRaise = false
+	g.logAction(playerID, CallAction.String(), callAmount)
 
-	// handle turn end
+	g.whoseTurn = g.getNextPlayersTurn()
+	g.notifyObservers()
 	return nil
 }
 
-// Raise increases the current bet.
+// RaiseTo raises the current bet so that the player's total bet for the round is the specified amount,
+// for clients that think in terms of "raise to X". Raising to the current bet is a call, not a raise,
+// so the total must be higher than the current bet.
+func (g *GameState) RaiseTo(playerID int, total int) error {
+	if total <= g.highestBetInRound {
+		return fmt.Errorf("error raising: raising to $%v doesn't exceed the current bet of $%v", total, g.highestBetInRound)
+	}
+	return g.Raise(playerID, total-g.highestBetInRound)
+}
+
+// AnnounceRaise declares the total the specified player is betting or raising to before they put their
+// chips in, as a player does in live poker to avoid a string bet. The announcement is binding: until the
+// player completes it with Bet, Raise, or RaiseTo for exactly the announced total, any other bet or raise,
+// check, or call is rejected. The player can still fold. It returns an error if the hand is over, it isn't
+// the player's turn, or the total isn't a legal bet or raise for them.
+func (g *GameState) AnnounceRaise(playerID int, total int) error {
+	if err := g.validateHandNotOver(); err != nil {
+		return fmt.Errorf("error announcing raise: %v", err)
+	}
+	if playerID != g.whoseTurn {
+		return fmt.Errorf("error announcing raise: %v", notYourTurnMsg(playerID, g.whoseTurn))
+	}
+	min, max := g.BetRange(playerID)
+	amount := total
+	if g.betInCurrentRound {
+		min, max = g.RaiseRange(playerID)
+		amount = total - g.highestBetInRound
+	}
+	if max == 0 || amount < min || amount > max {
+		return fmt.Errorf("error announcing raise: player %v can't bet or raise to $%v", playerID, total)
+	}
+	g.announcedTotal = total
+	return nil
+}
+
+// Raise increases the current bet by the specified amount, on top of the amount needed to call. Ex.
+// if the bet is $10, Raise(playerID, 20) makes the bet $30, the same as RaiseTo(playerID, 30).
+// A player can go all-in with a raise smaller than the minimum raise, but doing so doesn't reopen
+// the betting, so players who have acted since the last full bet or raise can only call or fold until
+// someone makes another.
 func (g *GameState) Raise(playerID int, amount int) error {
 	err := g.validateRaise(playerID, amount)
 	if err != nil {
 		return fmt.Errorf("error raising: %v", err)
 	}
+	g.table[playerID].canRaise = false
+	if amount >= g.minimumRaise() {
+		g.lastFullRaise = amount
+		g.reopenBetting(playerID)
+	}
 	// amount player is betting is call + raise
 	betAmount := g.callAmount(playerID) + amount
 	g.table[playerID].money -= betAmount
 	g.table[playerID].amountBetInRound += betAmount
-	g.pot += betAmount
 	g.highestBetInRound = g.table[playerID].amountBetInRound
+	g.announcedTotal = 0
+	g.table[playerID].actedInRound = true
+	g.logAction(playerID, RaiseAction.String(), betAmount)
+
+	g.whoseTurn = g.getNextPlayersTurn()
+	g.notifyObservers()
+	return nil
+}
+
+// CommittedThisHand returns the total amount the specified player has put into the pot during the
+// current hand, across every betting round and including blinds.
+func (g GameState) CommittedThisHand(playerID int) (int, error) {
+	if g.getTablePos(playerID) == -1 {
+		return 0, fmt.Errorf("there is no player with id %v", playerID)
+	}
+	return g.pot.Contribution(playerID) + g.table[playerID].amountBetInRound, nil
+}
+
+// Stack returns the amount of money the specified player has left to bet.
+func (g GameState) Stack(playerID int) (int, error) {
+	if g.getTablePos(playerID) == -1 {
+		return 0, fmt.Errorf("there is no player with id %v", playerID)
+	}
+	return g.table[playerID].money, nil
+}
+
+// EffectiveStack returns the smaller of the two players' stacks, which is the most that can be
+// wagered between them. It returns 0 if either player doesn't exist.
+func (g GameState) EffectiveStack(playerA, playerB int) int {
+	if g.getTablePos(playerA) == -1 || g.getTablePos(playerB) == -1 {
+		return 0
+	}
+	return minInt(g.table[playerA].money, g.table[playerB].money)
+}
+
+// StackInBigBlinds returns the specified player's stack divided by the big blind. With a blind schedule
+// it uses the big blind of the current level, which may not be posted until the next hand. It returns 0
+// if the player doesn't exist or there is no big blind.
+func (g GameState) StackInBigBlinds(playerID int) float64 {
+	bigBlind := g.bigBlindAmount
+	if len(g.clock.schedule) > 0 {
+		bigBlind = g.clock.schedule[g.clock.level].BigBlind
+	}
+	if g.getTablePos(playerID) == -1 || bigBlind <= 0 {
+		return 0
+	}
+	return float64(g.table[playerID].money) / float64(bigBlind)
+}
+
+// SPR returns the stack-to-pot ratio of the specified player, which is their effective stack against
+// the deepest opponent still in the round divided by the size of the pot. It returns -1 if the pot is
+// empty or the player doesn't exist.
+func (g GameState) SPR(playerID int) float64 {
+	if g.getTablePos(playerID) == -1 || g.potSize() == 0 {
+		return -1
+	}
+	effective := 0
+	for _, id := range g.participating {
+		if id != playerID {
+			if stack := g.EffectiveStack(playerID, id); stack > effective {
+				effective = stack
+			}
+		}
+	}
+	return float64(effective) / float64(g.potSize())
+}
 
-	// handle turn end
+// ShowCard reveals one of the specified player's hole cards to the table without revealing the other.
+// The card index is 0 or 1.
+func (g *GameState) ShowCard(playerID int, cardIndex int) error {
+	if g.getTablePos(playerID) == -1 {
+		return fmt.Errorf("error showing card: there is no player with id %v", playerID)
+	}
+	if cardIndex < 0 || cardIndex > 1 {
+		return fmt.Errorf("error showing card: card index must be 0 or 1, not %v", cardIndex)
+	}
+	g.table[playerID].shown[cardIndex] = true
+	g.notifyObservers()
+	return nil
+}
+
+// ShownCards returns the hole cards the specified player has shown to the table this round.
+func (g GameState) ShownCards(playerID int) ([]cards.Card, error) {
+	if g.getTablePos(playerID) == -1 {
+		return nil, fmt.Errorf("there is no player with id %v", playerID)
+	}
+	shown := []cards.Card{}
+	for i, isShown := range g.table[playerID].shown {
+		if isShown {
+			shown = append(shown, g.table[playerID].hand[i])
+		}
+	}
+	return shown, nil
+}
+
+// UnseenCards returns the cards the specified player can't see, which are every card except their
+// hole cards, the community cards, and any cards other players have shown. These are the cards that
+// could be in the deck or in another player's hand. It returns an empty slice if the player doesn't exist.
+func (g GameState) UnseenCards(playerID int) []cards.Card {
+	if g.getTablePos(playerID) == -1 {
+		return []cards.Card{}
+	}
+	seen := append([]cards.Card{g.table[playerID].hand[0], g.table[playerID].hand[1]}, g.community...)
+	for _, p := range g.table {
+		shown, _ := g.ShownCards(p.id)
+		seen = append(seen, shown...)
+	}
+	unseen := []cards.Card{}
+	for _, c := range cards.AllCards() {
+		if !cardInSlice(c, seen) {
+			unseen = append(unseen, c)
+		}
+	}
+	return unseen
+}
+
+// Returns an error if the hand is over, because one player is left, the betting on the river is
+// complete, or the pot has been distributed, so that a finished hand can't be changed.
+func (g GameState) validateHandNotOver() error {
+	if g.handComplete() {
+		return errors.New("the hand is over, no more actions can be taken")
+	}
 	return nil
 }
 
 func (g GameState) validateCheck(playerID int) error {
+	if err := g.validateHandNotOver(); err != nil {
+		return err
+	}
 	if playerID != g.whoseTurn {
 		return errors.New(notYourTurnMsg(playerID, g.whoseTurn))
 	}
+	if err := g.validateAnnounced(g.table[playerID].amountBetInRound); err != nil {
+		return err
+	}
 	if g.highestBetInRound > g.table[playerID].amountBetInRound {
 		return fmt.Errorf("cannot check, instead you must call or raise the current betting amount of %v",
 			g.highestBetInRound)
@@ -225,12 +991,18 @@ func (g GameState) validateCheck(playerID int) error {
 }
 
 func (g GameState) validateBet(playerID int, amount int) error {
+	if err := g.validateHandNotOver(); err != nil {
+		return err
+	}
 	if playerID != g.whoseTurn {
 		return errors.New(notYourTurnMsg(playerID, g.whoseTurn))
 	}
 	if g.betInCurrentRound {
 		return fmt.Errorf("can only Bet if there hasn't been a bet this round. If you wish to increase the bet, call Raise")
 	}
+	if err := g.validateAnnounced(amount); err != nil {
+		return err
+	}
 	minBet := g.minimumBet()
 	if amount < minBet {
 		return fmt.Errorf("minimum bet is $%v", minBet)
@@ -240,42 +1012,71 @@ func (g GameState) validateBet(playerID int, amount int) error {
 		return fmt.Errorf("player %v does not have enough money to bet $%v (they only have $%v)",
 			playerID, amount, playersMoney)
 	}
+	if maxBet := g.maximumBet(); amount > maxBet {
+		return fmt.Errorf("maximum bet is $%v", maxBet)
+	}
 
 	return nil
 }
 
 func (g GameState) validateCall(playerID int) error {
+	if err := g.validateHandNotOver(); err != nil {
+		return err
+	}
 	if playerID != g.whoseTurn {
 		return errors.New(notYourTurnMsg(playerID, g.whoseTurn))
 	}
-	player := g.table[playerID]
-	amountToCall := g.callAmount(playerID)
-	if amountToCall > player.money {
-		return fmt.Errorf("player %v does not have enough money to call $%v (they only have $%v)",
-			player.id,
-			amountToCall,
-			player.money)
+	if err := g.validateAnnounced(g.highestBetInRound); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 func (g GameState) validateRaise(playerID int, amount int) error {
+	if err := g.validateHandNotOver(); err != nil {
+		return err
+	}
 	if playerID != g.whoseTurn {
 		return errors.New(notYourTurnMsg(playerID, g.whoseTurn))
 	}
-	minRaise := g.minimumRaise()
-	if amount < minRaise {
-		return fmt.Errorf("minimum raise is $%v", minRaise)
+	player := g.table[playerID]
+	if !player.canRaise {
+		return fmt.Errorf("player %v cannot raise again because the last raise was an all-in smaller than a full raise, they can only call or fold",
+			playerID)
+	}
+	if amount <= 0 {
+		return fmt.Errorf("must raise by at least $1, not $%v", amount)
+	}
+	if player.money <= g.callAmount(playerID) {
+		return fmt.Errorf("player %v only has $%v, which doesn't cover the $%v to call, so they can only call all-in", playerID, player.money, g.callAmount(playerID))
+	}
+	if err := g.validateAnnounced(g.highestBetInRound + amount); err != nil {
+		return err
 	}
 	// amount to call + raise
 	callAmount := g.callAmount(playerID)
 	totalAmount := callAmount + amount
-	player := g.table[playerID]
+	minRaise := g.minimumRaise()
+	isAllIn := totalAmount == player.money
+	if amount < minRaise && !isAllIn {
+		return fmt.Errorf("minimum raise is $%v", minRaise)
+	}
 	if totalAmount > player.money {
 		return fmt.Errorf("player %v does not have enough money to raise, needs $%v ($%v to call plus $%v raise), but only has $%v",
 			playerID, totalAmount, callAmount, amount, player.money)
 	}
+	if maxRaise := g.maximumRaise(playerID); amount > maxRaise {
+		return fmt.Errorf("maximum raise is $%v", maxRaise)
+	}
+	return nil
+}
+
+// Returns an error if the player whose turn it is announced a raise and the action would leave their
+// total bet for the round at something other than the announced total.
+func (g GameState) validateAnnounced(total int) error {
+	if g.announcedTotal != 0 && total != g.announcedTotal {
+		return fmt.Errorf("player %v announced a raise to $%v, so they must bet exactly that amount", g.whoseTurn, g.announcedTotal)
+	}
 	return nil
 }
 
@@ -309,12 +1110,97 @@ func (g GameState) getWhoseTurn() player {
 	return g.table[tablePos]
 }
 
+// BetRange returns the smallest and largest amounts the specified player can Bet, given the betting
+// structure and their stack. Both are 0 if the player can't bet, such as when there has already been
+// a bet this round or they can't afford the minimum bet.
+func (g GameState) BetRange(playerID int) (min, max int) {
+	if g.getTablePos(playerID) == -1 || g.betInCurrentRound {
+		return 0, 0
+	}
+	min = g.minimumBet()
+	max = minInt(g.maximumBet(), g.table[playerID].money)
+	if max < min {
+		return 0, 0
+	}
+	return min, max
+}
+
+// RaiseRange returns the smallest and largest amounts the specified player can Raise by, on top of
+// the amount needed to call, given the betting structure and their stack. If the player can't afford
+// the minimum raise, both are the all-in amount. Both are 0 if the player can't raise, such as when
+// there hasn't been a bet this round or the betting hasn't been reopened since they acted.
+func (g GameState) RaiseRange(playerID int) (min, max int) {
+	if g.getTablePos(playerID) == -1 || !g.betInCurrentRound {
+		return 0, 0
+	}
+	if !g.table[playerID].canRaise {
+		return 0, 0
+	}
+	min = g.minimumRaise()
+	max = minInt(g.maximumRaise(playerID), g.table[playerID].money-g.callAmount(playerID))
+	if max <= 0 {
+		return 0, 0
+	}
+	if max < min {
+		return max, max
+	}
+	return min, max
+}
+
 func (g GameState) minimumBet() int {
+	if g.rules.Betting == FixedLimit {
+		return g.limitBetSize()
+	}
+	if minBet, ok := g.rules.MinimumBets[g.phase]; ok {
+		return minBet
+	}
 	return g.bigBlindAmount
 }
 
+// Returns the smallest amount a raise can be. Outside of fixed limit this is the size of the last bet or
+// full raise, so an all-in raise smaller than a full raise doesn't change it.
 func (g GameState) minimumRaise() int {
-	return g.highestBetInRound
+	if g.rules.Betting == FixedLimit {
+		return g.limitBetSize()
+	}
+	return g.lastFullRaise
+}
+
+// Returns the largest bet allowed by the betting structure, regardless of the player's stack.
+func (g GameState) maximumBet() int {
+	switch g.rules.Betting {
+	case PotLimit:
+		return g.potSize()
+	case FixedLimit:
+		return g.limitBetSize()
+	default:
+		return math.MaxInt
+	}
+}
+
+// Returns the largest raise allowed by the betting structure, regardless of the player's stack.
+// In pot limit the player can raise by the size of the pot after they call.
+func (g GameState) maximumRaise(playerID int) int {
+	switch g.rules.Betting {
+	case PotLimit:
+		return g.potSize() + g.callAmount(playerID)
+	case FixedLimit:
+		return g.limitBetSize()
+	default:
+		return math.MaxInt
+	}
+}
+
+// Returns the size of a bet in fixed limit, which doubles on the turn and river unless the rules set
+// the minimum bet for the street.
+func (g GameState) limitBetSize() int {
+	if size, ok := g.rules.MinimumBets[g.phase]; ok {
+		return size
+	}
+	if g.phase >= Turn {
+		return g.bigBlindAmount * 2
+	}
+	return g.bigBlindAmount
 }
 
 // Returns the index of the table where the player with the specified id is or -1 if no player is found.
@@ -338,7 +1224,7 @@ func intInSlice(i int, s []int) bool {
 
 // Removes the specified int from a slice of ints and returns the result.
 // If the int is not found in the slice, an error is returned.
-// Note that the resulting slice may not be in the same order as it was passed in.
+// The remaining ints keep their order, and the passed in slice is modified.
 func removeIntFromSlice(nums []int, n int) ([]int, error) {
 	idxOfInt := -1
 	for idx, val := range nums {
@@ -351,7 +1237,8 @@ func removeIntFromSlice(nums []int, n int) ([]int, error) {
 		return []int{}, fmt.Errorf("couldn't find specified int in the slice: %v is not in %v", n, nums)
 	}
 
-	nums[idxOfInt] = nums[len(nums)-1]
+	// shift the elements after the removed int left so the order of the rest is preserved
+	copy(nums[idxOfInt:], nums[idxOfInt+1:])
 	nums[len(nums)-1] = 0
 	nums = nums[:len(nums)-1]
 	return nums, nil
@@ -1,14 +1,1198 @@
 package game
 
 import (
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
 )
 
 func TestNewRound(t *testing.T) {
-	gameState := NewGame(5, 100, 4)
+	gameState, _ := NewGame(5, 100, 4)
 	gameState.newRound()
 	// fmt.Printf("GameState: %v", gameState)
 	t.Logf("GameState: %v", gameState)
 	gameState.newRound()
 	t.Logf("GameState: %v", gameState)
 }
+
+func TestCommittedThisHand(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Player 0 is the small blind and player 1 is the big blind.
+	expectedAfterBlinds := map[int]int{0: 2, 1: 4, 2: 0}
+	for id, expected := range expectedAfterBlinds {
+		committed, _ := g.CommittedThisHand(id)
+		if committed != expected {
+			t.Errorf("Expected player %v to have committed %v after blinds, but instead they committed %v.", id, expected, committed)
+		}
+	}
+
+	g.Raise(2, 6)
+	g.whoseTurn = 0
+	g.Call(0)
+	g.whoseTurn = 1
+	g.Raise(1, 10)
+	expectedAfterBetting := map[int]int{0: 10, 1: 20, 2: 10}
+	for id, expected := range expectedAfterBetting {
+		committed, _ := g.CommittedThisHand(id)
+		if committed != expected {
+			t.Errorf("Expected player %v to have committed %v after betting, but instead they committed %v.", id, expected, committed)
+		}
+	}
+
+	g.newRound()
+	// The button moves to player 0, who doesn't post a blind in the new round.
+	committed, _ := g.CommittedThisHand(0)
+	if committed != 0 {
+		t.Errorf("Expected committed amounts to reset in a new round, but player 0 has committed %v.", committed)
+	}
+}
+
+func TestCollectBets(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	if g.pot.Total() != 0 || g.potSize() != 6 {
+		t.Errorf("Expected the blinds to be uncollected bets, but instead the pot had %v collected and %v in total.", g.pot.Total(), g.potSize())
+	}
+	g.Call(2)
+	g.Call(0)
+	g.Check(1)
+	g.AdvancePhase()
+	if g.pot.Total() != 12 || g.potSize() != 12 {
+		t.Errorf("Expected the preflop bets to be collected into a pot of 12, but instead %v was collected and %v was in total.", g.pot.Total(), g.potSize())
+	}
+	for _, p := range g.table {
+		if p.amountBetInRound != 0 {
+			t.Errorf("Expected player %v's bet to be reset after it was collected, but it was %v.", p.id, p.amountBetInRound)
+		}
+	}
+
+	// Player 1 calls all-in for less than player 0's bet, and player 2 calls.
+	g.table[1].money = 5
+	g.Bet(0, 10)
+	g.Call(1)
+	g.Call(2)
+	if g.pot.Total() != 12 || g.potSize() != 37 {
+		t.Errorf("Expected the flop bets not to be collected before the street ends, but instead %v was collected and %v was in total.", g.pot.Total(), g.potSize())
+	}
+	g.AdvancePhase()
+	expected := []SidePot{{27, []int{0, 1, 2}}, {10, []int{0, 2}}}
+	if pots := g.pot.SidePots(g.participating); !reflect.DeepEqual(pots, expected) {
+		t.Errorf("Expected the pots after the flop to be %v, but instead they were %v.", expected, pots)
+	}
+}
+
+func TestCommittedThisHandInvalidPlayer(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	if _, err := g.CommittedThisHand(7); err == nil {
+		t.Errorf("Expected an error for a player that doesn't exist, but there wasn't one.")
+	}
+}
+
+func TestAdvancePhase(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	expectedCommunity := map[Phase]int{Flop: 3, Turn: 4, River: 5, Showdown: 5}
+	for _, phase := range []Phase{Flop, Turn, River, Showdown} {
+		if err := g.AdvancePhase(); err != nil {
+			t.Fatalf("Expected advancing to phase %v not to return an error, but it returned %v.", phase, err)
+		}
+		if g.phase != phase {
+			t.Errorf("Expected the phase to be %v, but instead it was %v.", phase, g.phase)
+		}
+		if len(g.community) != expectedCommunity[phase] {
+			t.Errorf("Expected %v community cards in phase %v, but there were %v.", expectedCommunity[phase], phase, len(g.community))
+		}
+	}
+	if err := g.AdvancePhase(); err == nil {
+		t.Errorf("Expected an error advancing past the showdown, but there wasn't one.")
+	}
+}
+
+// Tests that the betting state resets when the flop is dealt, so players who called preflop can check.
+func TestAdvancePhaseResetsBetting(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	if err := g.Call(2); err != nil {
+		t.Fatalf("Expected player 2 to be able to call the big blind, but there was an error: %v", err)
+	}
+	g.AdvancePhase()
+	if g.highestBetInRound != 0 || g.betInCurrentRound {
+		t.Errorf("Expected the betting round to reset on the flop, but the highest bet was %v and betInCurrentRound was %v.",
+			g.highestBetInRound,
+			g.betInCurrentRound)
+	}
+	for _, p := range g.table {
+		if p.amountBetInRound != 0 {
+			t.Errorf("Expected player %v's bet to reset on the flop, but it was %v.", p.id, p.amountBetInRound)
+		}
+	}
+	// The small blind is the first player left of the button.
+	if g.whoseTurn != 0 {
+		t.Errorf("Expected player 0 to act first on the flop, but it was player %v's turn.", g.whoseTurn)
+	}
+	if err := g.Check(0); err != nil {
+		t.Errorf("Expected player 0 to be able to check on the flop, but there was an error: %v", err)
+	}
+	g.whoseTurn = 2
+	if err := g.Check(2); err != nil {
+		t.Errorf("Expected player 2 to be able to check on the flop after calling preflop, but there was an error: %v", err)
+	}
+}
+
+func TestFoldOutOfTurn(t *testing.T) {
+	tests := []struct {
+		rules       Rules
+		expectError bool
+	}{
+		{Rules{}, true},
+		{Rules{AllowOutOfTurnFold: true}, false},
+	}
+	for _, test := range tests {
+		g, _ := NewGameWithRules(4, 100, 4, test.rules, nil)
+		g.newRound()
+		// It is player 2's turn.
+		err := g.Fold(3)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("Expected an error folding out of turn with rules %+v, but there wasn't one.", test.rules)
+			}
+			if !intInSlice(3, g.participating) {
+				t.Errorf("Expected player 3 to still be participating after an invalid fold.")
+			}
+		} else {
+			if err != nil {
+				t.Errorf("Expected folding out of turn with rules %+v to succeed, but there was an error: %v", test.rules, err)
+			}
+			if intInSlice(3, g.participating) {
+				t.Errorf("Expected player 3 to be removed from the round after folding out of turn.")
+			}
+			if g.whoseTurn != 2 {
+				t.Errorf("Expected it to still be player 2's turn, but it was player %v's turn.", g.whoseTurn)
+			}
+		}
+	}
+}
+
+func TestFoldOutOfTurnNotParticipating(t *testing.T) {
+	g, _ := NewGameWithRules(4, 100, 4, Rules{AllowOutOfTurnFold: true}, nil)
+	g.newRound()
+	g.Fold(3)
+	if err := g.Fold(3); err == nil {
+		t.Errorf("Expected an error folding a player who already folded, but there wasn't one.")
+	}
+}
+
+func TestClone(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.AdvancePhase()
+	original := g.Clone()
+
+	clone := g.Clone()
+	clone.Call(clone.whoseTurn)
+	clone.table[0].money = 1
+	clone.pot.Add(0, 50)
+	clone.table[1].hand[0] = cards.NewCard(cards.Two, cards.Club)
+	clone.participating[0] = 99
+	clone.community[0] = cards.NewCard(cards.Two, cards.Club)
+	clone.AdvancePhase()
+	clone.Fold(clone.whoseTurn)
+
+	if g.table[0].money != original.table[0].money || g.table[1].hand != original.table[1].hand {
+		t.Errorf("Expected modifying the clone's players not to affect the original.")
+	}
+	if g.pot.Total() != original.pot.Total() || g.pot.Contribution(0) != original.pot.Contribution(0) {
+		t.Errorf("Expected adding to the clone's pot not to affect the original.")
+	}
+	if !intSlicesEqual(g.participating, original.participating) {
+		t.Errorf("Expected modifying the clone's participants not to affect the original, but they are %v instead of %v.",
+			g.participating,
+			original.participating)
+	}
+	if len(g.community) != 3 || g.community[0] != original.community[0] {
+		t.Errorf("Expected modifying the clone's community cards not to affect the original, but they are %v.", g.community)
+	}
+	if g.deck.Length() != original.deck.Length() || g.phase != Flop {
+		t.Errorf("Expected advancing the clone not to affect the original deck or phase.")
+	}
+}
+
+func intSlicesEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSetBoard(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	deckSize := g.deck.Length()
+	// Use cards that are still in the deck, since the hole cards are random.
+	board := append([]cards.Card{}, g.deck.GetCards()[:4]...)
+	flopNotation := strings.Join([]string{cardNotation(board[0]), cardNotation(board[1]), cardNotation(board[2])}, " ")
+	if err := g.SetBoard(flopNotation); err != nil {
+		t.Fatalf("Expected SetBoard(%q) to succeed, but there was an error: %v", flopNotation, err)
+	}
+	if len(g.community) != 3 || g.community[0] != board[0] || g.community[1] != board[1] || g.community[2] != board[2] {
+		t.Errorf("Expected the community cards to be %v, but instead they were %v.", board[:3], g.community)
+	}
+	if g.phase != Flop {
+		t.Errorf("Expected the phase to be the flop, but instead it was %v.", g.phase)
+	}
+	if g.deck.Length() != deckSize-3 {
+		t.Errorf("Expected the board to be removed from the deck, but the deck has %v cards instead of %v.", g.deck.Length(), deckSize-3)
+	}
+
+	// The existing board cards can be reused when adding the turn.
+	turnNotation := flopNotation + " " + cardNotation(board[3])
+	if err := g.SetBoard(turnNotation); err != nil {
+		t.Fatalf("Expected SetBoard(%q) to succeed when adding the turn, but there was an error: %v", turnNotation, err)
+	}
+	if g.phase != Turn || g.deck.Length() != deckSize-4 {
+		t.Errorf("Expected the turn to be removed from the deck, but the phase is %v and the deck has %v cards.", g.phase, g.deck.Length())
+	}
+	g.AdvancePhase()
+	if len(g.community) != 5 {
+		t.Errorf("Expected the river to be dealt after the board was set, but there are %v community cards.", len(g.community))
+	}
+}
+
+func TestSetBoardInvalid(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	inDeck := g.deck.GetCards()
+	// The first card has already been dealt to player 0, so it's no longer in the deck.
+	dealtBoard := strings.Join([]string{cardNotation(g.table[0].hand[0]), cardNotation(inDeck[0]), cardNotation(inDeck[1])}, " ")
+
+	tests := []string{"Ah Kd", "Ah Kd 7c 2s 3s 4s", "Ah Ah 7c", "Ah Kx 7c", dealtBoard}
+	for _, test := range tests {
+		if err := g.SetBoard(test); err == nil {
+			t.Errorf("Expected SetBoard(%q) to return an error, but it didn't.", test)
+		}
+	}
+	if len(g.community) != 0 || g.deck.Length() != 52-6 {
+		t.Errorf("Expected an invalid board not to change the game, but the board is %v and the deck has %v cards.", g.community, g.deck.Length())
+	}
+}
+
+func TestDealCommunity(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	remaining := g.deck.Remaining()
+	if err := g.DealCommunity(3); err != nil {
+		t.Fatalf("Expected dealing a flop not to return an error, but it returned %v.", err)
+	}
+	if len(g.community) != 3 || g.deck.Remaining() != remaining-4 {
+		t.Errorf("Expected a flop to be dealt after a burn, but the board has %v cards and %v were drawn.", len(g.community), remaining-g.deck.Remaining())
+	}
+	if err := g.DealCommunity(1); err != nil {
+		t.Fatalf("Expected dealing a turn not to return an error, but it returned %v.", err)
+	}
+	if len(g.community) != 4 || g.deck.Remaining() != remaining-6 {
+		t.Errorf("Expected a turn to be dealt after a burn, but the board has %v cards and %v were drawn.", len(g.community), remaining-g.deck.Remaining())
+	}
+	if g.phase != PreFlop {
+		t.Errorf("Expected dealing community cards not to change the phase, but it is %v.", g.phase)
+	}
+	for _, n := range []int{0, 2} {
+		if err := g.DealCommunity(n); err == nil {
+			t.Errorf("Expected dealing %v cards onto a board of 4 to return an error, but it didn't.", n)
+		}
+	}
+	if len(g.community) != 4 {
+		t.Errorf("Expected invalid deals not to change the board, but it has %v cards.", len(g.community))
+	}
+}
+
+func TestRevealStreets(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	obs := &recordingObserver{}
+	g.AddGodViewObserver(obs)
+	steps := []struct {
+		name      string
+		reveal    func() error
+		community int
+	}{
+		{"flop", g.RevealFlop, 3},
+		{"turn", g.RevealTurn, 4},
+		{"river", g.RevealRiver, 5},
+	}
+	for i, step := range steps {
+		if err := step.reveal(); err != nil {
+			t.Fatalf("Expected revealing the %v not to return an error, but it returned %v.", step.name, err)
+		}
+		if len(g.community) != step.community || len(obs.views) != i+1 {
+			t.Fatalf("Expected the %v to show %v community cards in %v events, but there were %v cards and %v events.",
+				step.name, step.community, i+1, len(g.community), len(obs.views))
+		}
+		if seen := len(obs.views[i].Community); seen != step.community {
+			t.Errorf("Expected observers to see %v community cards after the %v, but they saw %v.", step.community, step.name, seen)
+		}
+	}
+	// the streets can only be revealed in order
+	for _, reveal := range []func() error{g.RevealFlop, g.RevealTurn, g.RevealRiver} {
+		if err := reveal(); err == nil {
+			t.Errorf("Expected revealing a street on the river to return an error, but it didn't.")
+		}
+	}
+	if len(g.community) != 5 || len(obs.views) != 3 {
+		t.Errorf("Expected failed reveals not to deal cards or send events, but there were %v cards and %v events.", len(g.community), len(obs.views))
+	}
+}
+
+// Returns the notation of a card accepted by cards.ParseCard. Ex. "Ah"
+func cardNotation(c cards.Card) string {
+	suits := map[cards.Suit]string{cards.Spade: "s", cards.Club: "c", cards.Heart: "h", cards.Diamond: "d"}
+	ranks := "--23456789TJQKA"
+	return string(ranks[c.Rank()]) + suits[c.Suit()]
+}
+
+func TestBetAndRaiseRange(t *testing.T) {
+	tests := []struct {
+		betting         BettingStructure
+		preflopRaiseMin int
+		preflopRaiseMax int
+		flopBetMin      int
+		flopBetMax      int
+		turnBetMin      int
+		turnBetMax      int
+	}{
+		{NoLimit, 4, 96, 4, 98, 4, 98},
+		{PotLimit, 4, 10, 4, 6, 4, 6},
+		{FixedLimit, 4, 4, 4, 4, 8, 8},
+	}
+	for _, test := range tests {
+		g, _ := NewGameWithRules(3, 100, 4, Rules{Betting: test.betting}, nil)
+		g.newRound()
+		// Blinds of 2 and 4 are in the pot and it is player 2's turn.
+		if min, max := g.BetRange(2); min != 0 || max != 0 {
+			t.Errorf("%v: Expected player 2 to be unable to bet preflop, but the bet range was %v to %v.", test.betting, min, max)
+		}
+		if min, max := g.RaiseRange(2); min != test.preflopRaiseMin || max != test.preflopRaiseMax {
+			t.Errorf("%v: Expected the preflop raise range to be %v to %v, but instead it was %v to %v.",
+				test.betting, test.preflopRaiseMin, test.preflopRaiseMax, min, max)
+		}
+
+		g.AdvancePhase()
+		if min, max := g.BetRange(0); min != test.flopBetMin || max != test.flopBetMax {
+			t.Errorf("%v: Expected the flop bet range to be %v to %v, but instead it was %v to %v.",
+				test.betting, test.flopBetMin, test.flopBetMax, min, max)
+		}
+		if min, max := g.RaiseRange(0); min != 0 || max != 0 {
+			t.Errorf("%v: Expected player 0 to be unable to raise before a bet, but the raise range was %v to %v.", test.betting, min, max)
+		}
+
+		g.AdvancePhase()
+		if min, max := g.BetRange(0); min != test.turnBetMin || max != test.turnBetMax {
+			t.Errorf("%v: Expected the turn bet range to be %v to %v, but instead it was %v to %v.",
+				test.betting, test.turnBetMin, test.turnBetMax, min, max)
+		}
+	}
+}
+
+// Tests that bets and raises outside the limits of the betting structure are rejected.
+func TestBettingStructureLimits(t *testing.T) {
+	tests := []struct {
+		betting    BettingStructure
+		flopBet    int
+		raise      int
+		validBet   bool
+		validRaise bool
+	}{
+		// The pot is 6 on the flop.
+		{PotLimit, 7, 0, false, false},
+		{PotLimit, 6, 18, true, true},
+		{PotLimit, 6, 19, true, false},
+		{FixedLimit, 5, 0, false, false},
+		{FixedLimit, 4, 4, true, true},
+		{FixedLimit, 4, 8, true, false},
+		{NoLimit, 50, 50, true, false},
+		{NoLimit, 40, 40, true, true},
+	}
+	for _, test := range tests {
+		g, _ := NewGameWithRules(3, 100, 4, Rules{Betting: test.betting}, nil)
+		g.newRound()
+		g.AdvancePhase()
+		err := g.Bet(0, test.flopBet)
+		if (err == nil) != test.validBet {
+			t.Errorf("%v: Expected betting %v to be valid: %v, but the error was %v.", test.betting, test.flopBet, test.validBet, err)
+		}
+		if !test.validBet {
+			continue
+		}
+		err = g.Raise(1, test.raise)
+		if (err == nil) != test.validRaise {
+			t.Errorf("%v: Expected raising by %v after a bet of %v to be valid: %v, but the error was %v.",
+				test.betting, test.raise, test.flopBet, test.validRaise, err)
+		}
+	}
+}
+
+// Returns a game on the flop where player 0 has bet $10 and player 1 has gone all-in with a $5 raise,
+// which is less than a full raise. It is player 2's turn.
+func shortAllInGame(t *testing.T) GameState {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.AdvancePhase()
+	if err := g.Bet(0, 10); err != nil {
+		t.Fatalf("Expected player 0 to be able to bet, but instead got error: %v", err)
+	}
+	g.table[1].money = 15
+	if err := g.Raise(1, 5); err != nil {
+		t.Fatalf("Expected player 1 to be able to go all-in for less than a full raise, but instead got error: %v", err)
+	}
+	g.whoseTurn = 2
+	return g
+}
+
+func TestRaiseNotReopened(t *testing.T) {
+	g := shortAllInGame(t)
+	if err := g.Call(2); err != nil {
+		t.Fatalf("Expected player 2 to be able to call, but instead got error: %v", err)
+	}
+	g.whoseTurn = 0
+	if err := g.Raise(0, 20); err == nil {
+		t.Errorf("Expected player 0 to be unable to re-raise after a short all-in, but the raise succeeded.")
+	}
+	if min, max := g.RaiseRange(0); min != 0 || max != 0 {
+		t.Errorf("Expected player 0 to have no raise range, but instead it was %v to %v.", min, max)
+	}
+	if err := g.Call(0); err != nil {
+		t.Errorf("Expected player 0 to be able to call after a short all-in, but instead got error: %v", err)
+	}
+}
+
+func TestRaiseReopened(t *testing.T) {
+	g := shortAllInGame(t)
+	// Player 2 hasn't acted yet, so they can still raise, and a full raise reopens the betting.
+	if err := g.Raise(2, 20); err != nil {
+		t.Fatalf("Expected player 2 to be able to raise, but instead got error: %v", err)
+	}
+	g.whoseTurn = 0
+	if err := g.Raise(0, 40); err != nil {
+		t.Errorf("Expected player 0 to be able to re-raise after a full raise, but instead got error: %v", err)
+	}
+}
+
+func TestRaiseOpenAfterFullRaise(t *testing.T) {
+	g, _ := NewGame(3, 1000, 4)
+	g.newRound()
+	g.AdvancePhase()
+	g.Bet(0, 100)
+	g.Raise(1, 100)
+	// Player 2 goes all-in to $250, short of a full raise, so player 1 can't raise again, but player 0
+	// hasn't acted since player 1's full raise.
+	g.table[2].money = 250
+	if err := g.Raise(2, 50); err != nil {
+		t.Fatalf("Expected player 2 to be able to go all-in for less than a full raise, but instead got error: %v", err)
+	}
+	if min, max := g.RaiseRange(1); min != 0 || max != 0 {
+		t.Errorf("Expected player 1 to have no raise range, but instead it was %v to %v.", min, max)
+	}
+	if err := g.Raise(0, 100); err != nil {
+		t.Errorf("Expected player 0 to be able to re-raise after player 1's full raise, but instead got error: %v", err)
+	}
+}
+
+func TestRaiseNotPositive(t *testing.T) {
+	g, _ := NewGame(3, 1000, 4)
+	g.newRound()
+	g.AdvancePhase()
+	g.Bet(0, 100)
+	// Player 1 can't cover the bet, so their all-in is a call.
+	g.table[1].money = 95
+	for _, amount := range []int{-5, 0, 1} {
+		if err := g.Raise(1, amount); err == nil {
+			t.Errorf("Expected a raise of %v by a player who can't cover the bet to fail, but it succeeded.", amount)
+		}
+	}
+	if g.highestBetInRound != 100 {
+		t.Errorf("Expected the bet to stay $100, but instead it was $%v.", g.highestBetInRound)
+	}
+	if err := g.Call(1); err != nil {
+		t.Errorf("Expected player 1 to be able to call all-in, but instead got error: %v", err)
+	}
+	if err := g.Raise(2, 0); err == nil {
+		t.Errorf("Expected a raise of 0 to fail, but it succeeded.")
+	}
+}
+
+func TestRaiseShortOfMinimum(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.AdvancePhase()
+	g.Bet(0, 10)
+	// A raise smaller than the minimum is only allowed when it puts the player all-in.
+	if err := g.Raise(1, 5); err == nil {
+		t.Errorf("Expected a $5 raise that isn't all-in to fail, but it succeeded.")
+	}
+	g.table[1].money = 15
+	if min, max := g.RaiseRange(1); min != 5 || max != 5 {
+		t.Errorf("Expected the all-in raise range to be 5 to 5, but instead it was %v to %v.", min, max)
+	}
+}
+
+func TestMinimumRaiseAfterShortAllIn(t *testing.T) {
+	g, _ := NewGame(3, 1000, 4)
+	g.newRound()
+	g.AdvancePhase()
+	g.Bet(0, 100)
+	// Player 1 goes all-in to $150, which is short of a full raise to $200.
+	g.table[1].money = 150
+	if err := g.Raise(1, 50); err != nil {
+		t.Fatalf("Expected player 1 to be able to go all-in for less than a full raise, but instead got error: %v", err)
+	}
+	// The minimum raise is still the $100 of the last full bet, not the $150 current bet.
+	if min, _ := g.RaiseRange(2); min != 100 {
+		t.Errorf("Expected player 2's minimum raise to be $100, but instead it was $%v.", min)
+	}
+	if err := g.Raise(2, 99); err == nil {
+		t.Errorf("Expected a $99 raise to be less than the minimum raise, but it succeeded.")
+	}
+	if err := g.RaiseTo(2, 250); err != nil {
+		t.Errorf("Expected player 2 to be able to raise to $250, but instead got error: %v", err)
+	}
+	// A full raise of $100 sets the next minimum raise.
+	if min, _ := g.RaiseRange(0); min != 100 {
+		t.Errorf("Expected player 0's minimum raise to be $100, but instead it was $%v.", min)
+	}
+}
+
+func TestAnnounceRaise(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Player 2 acts first preflop, facing the $4 big blind.
+	if err := g.AnnounceRaise(2, 20); err != nil {
+		t.Fatalf("Expected player 2 to be able to announce a raise to $20, but instead got error: %v", err)
+	}
+	if err := g.Call(2); err == nil {
+		t.Errorf("Expected player 2 to be unable to call after announcing a raise, but the call succeeded.")
+	}
+	if err := g.RaiseTo(2, 12); err == nil {
+		t.Errorf("Expected player 2 to be unable to raise to less than the announced $20, but the raise succeeded.")
+	}
+	if err := g.RaiseTo(2, 20); err != nil {
+		t.Fatalf("Expected player 2 to be able to complete the announced raise, but instead got error: %v", err)
+	}
+	// The announcement only binds the player who made it.
+	if err := g.Call(0); err != nil {
+		t.Errorf("Expected player 0 to be able to call after player 2 completed their raise, but instead got error: %v", err)
+	}
+
+	g.newRound()
+	g.AdvancePhase()
+	first := g.whoseTurn
+	if err := g.AnnounceRaise(first, 3); err == nil {
+		t.Errorf("Expected announcing a bet smaller than the minimum bet to fail, but it succeeded.")
+	}
+	if err := g.AnnounceRaise(first, 30); err != nil {
+		t.Fatalf("Expected player %v to be able to announce a bet of $30, but instead got error: %v", first, err)
+	}
+	if err := g.Check(first); err == nil {
+		t.Errorf("Expected player %v to be unable to check after announcing a bet, but the check succeeded.", first)
+	}
+	if err := g.Bet(first, 10); err == nil {
+		t.Errorf("Expected a $10 bet after announcing $30 to fail, but it succeeded.")
+	}
+	if err := g.Bet(first, 30); err != nil {
+		t.Errorf("Expected player %v to be able to complete the announced bet, but instead got error: %v", first, err)
+	}
+	if err := g.AnnounceRaise(first, 100); err == nil {
+		t.Errorf("Expected announcing a raise out of turn to fail, but it succeeded.")
+	}
+}
+
+func TestRebuy(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.table[2].money = 0
+	g.newRound()
+	if g.table[2].alive || intInSlice(2, g.participating) {
+		t.Errorf("Expected player 2 to be out of the game after running out of money, but they were dealt in.")
+	}
+	if err := g.Rebuy(0, 50); err == nil {
+		t.Errorf("Expected player 0 to be unable to rebuy during a hand they are playing, but the rebuy succeeded.")
+	}
+	if err := g.Rebuy(2, 0); err == nil {
+		t.Errorf("Expected a rebuy for $0 to fail, but it succeeded.")
+	}
+	if err := g.Rebuy(2, 100); err != nil {
+		t.Fatalf("Expected player 2 to be able to rebuy in a cash game, but instead got error: %v", err)
+	}
+	g.newRound()
+	if !intInSlice(2, g.participating) || g.table[2].money+g.table[2].amountBetInRound != 100 {
+		t.Errorf("Expected player 2 to be dealt in with $100 after rebuying, but instead they were dealt in: %v with $%v.",
+			intInSlice(2, g.participating), g.table[2].money)
+	}
+
+	tournament, _ := NewGameWithRules(3, 100, 4, Rules{Mode: TournamentGame}, nil)
+	tournament.table[2].money = 0
+	tournament.newRound()
+	if err := tournament.Rebuy(2, 100); err == nil {
+		t.Errorf("Expected player 2 to be unable to rebuy in a tournament, but the rebuy succeeded.")
+	}
+}
+
+func TestShortBlinds(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	// Player 0 posts the small blind and player 1 posts the big blind.
+	g.table[0].money = 1
+	g.table[1].money = 3
+	g.newRound()
+	expected := map[int]int{0: 1, 1: 3}
+	for id, posted := range expected {
+		if g.table[id].money != 0 || g.table[id].amountBetInRound != posted {
+			t.Errorf("Expected player %v to post $%v all-in, but instead they posted $%v and have $%v left.",
+				id, posted, g.table[id].amountBetInRound, g.table[id].money)
+		}
+		if g.CanAct(id) {
+			t.Errorf("Expected player %v to be all-in after posting a short blind, but they can still act.", id)
+		}
+	}
+	if g.callAmount(2) != 4 {
+		t.Errorf("Expected player 2 to have to call the full big blind of $4, but instead the call was $%v.", g.callAmount(2))
+	}
+}
+
+func TestButtonPosition(t *testing.T) {
+	tests := []struct {
+		numPlayers int
+		eliminated []int
+		// expected button, small blind, and big blind positions for each round
+		expected [][3]int
+	}{
+		{3, []int{}, [][3]int{{2, 0, 1}, {0, 1, 2}, {1, 2, 0}, {2, 0, 1}}},
+		{5, []int{}, [][3]int{{4, 0, 1}, {0, 1, 2}, {1, 2, 3}}},
+		// Heads-up the button is the small blind.
+		{2, []int{}, [][3]int{{0, 0, 1}, {1, 1, 0}, {0, 0, 1}}},
+		// Eliminated players are skipped when moving the button and blinds.
+		{5, []int{1, 3}, [][3]int{{4, 0, 1}, {0, 2, 4}, {2, 4, 0}, {4, 0, 2}}},
+		// Once only two players remain the button posts the small blind.
+		{4, []int{1, 2}, [][3]int{{3, 0, 1}, {0, 0, 3}, {3, 3, 0}}},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(test.numPlayers, 100, 4)
+		for round, expected := range test.expected {
+			if round == 1 {
+				for _, id := range test.eliminated {
+					g.table[id].alive = false
+				}
+			}
+			g.newRound()
+			actual := [3]int{g.ButtonPosition(), g.smallBlindPos, g.bigBlindPos}
+			if actual != expected {
+				t.Errorf("Expected round %v with %v players and %v eliminated to have button, small blind, and big blind at %v, but instead they were at %v.",
+					round, test.numPlayers, test.eliminated, expected, actual)
+			}
+		}
+	}
+}
+
+func TestFirstToActAfterFlopHeadsUp(t *testing.T) {
+	g, _ := NewGame(2, 100, 4)
+	g.newRound()
+	// The button posts the small blind and acts first preflop, but last after the flop.
+	if g.whoseTurn != 0 {
+		t.Errorf("Expected the button to act first preflop, but instead it was player %v's turn.", g.whoseTurn)
+	}
+	g.AdvancePhase()
+	if g.whoseTurn != 1 {
+		t.Errorf("Expected the big blind to act first after the flop, but instead it was player %v's turn.", g.whoseTurn)
+	}
+}
+
+func TestEffectiveStack(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.table[0].money = 250
+	g.table[1].money = 40
+	tests := []struct {
+		playerA  int
+		playerB  int
+		expected int
+	}{
+		{0, 1, 40},
+		{1, 0, 40},
+		{0, 2, 100},
+		{0, 0, 250},
+		{0, 3, 0},
+	}
+	for _, test := range tests {
+		actual := g.EffectiveStack(test.playerA, test.playerB)
+		if actual != test.expected {
+			t.Errorf("Expected EffectiveStack(%v, %v) to return %v, but instead it returned %v.",
+				test.playerA, test.playerB, test.expected, actual)
+		}
+	}
+}
+
+func TestFoldAdvancesTurn(t *testing.T) {
+	g, _ := NewGameWithRules(4, 100, 4, Rules{AllowOutOfTurnFold: true}, nil)
+	g.newRound()
+	// Player 3 has the button, so after player 2 acts preflop it's the button's turn.
+	g.Fold(2)
+	if g.whoseTurn != 3 {
+		t.Fatalf("Expected it to be the button's turn after player 2 folded, but instead it was player %v's turn.", g.whoseTurn)
+	}
+	if err := g.Fold(3); err != nil {
+		t.Fatalf("Expected the button to be able to fold, but instead got error: %v", err)
+	}
+	if g.whoseTurn != 0 {
+		t.Errorf("Expected it to be the small blind's turn after the button folded, but instead it was player %v's turn.", g.whoseTurn)
+	}
+	// Folding out of turn doesn't change whose turn it is.
+	g.Fold(1)
+	if g.whoseTurn != 0 {
+		t.Errorf("Expected it to still be player 0's turn after player 1 folded out of turn, but instead it was player %v's turn.", g.whoseTurn)
+	}
+}
+
+func TestFoldPreservesParticipatingOrder(t *testing.T) {
+	g, _ := NewGame(5, 100, 4)
+	g.newRound()
+	g.whoseTurn = 2
+	g.Fold(2)
+	expected := []int{0, 1, 3, 4}
+	if !intSlicesEqual(g.participating, expected) {
+		t.Errorf("Expected the participating players to be %v after player 2 folded, but instead they were %v.", expected, g.participating)
+	}
+}
+
+func TestNewGamePlayerCount(t *testing.T) {
+	tests := []struct {
+		numPlayers int
+		valid      bool
+	}{
+		{1, false},
+		{2, true},
+		{10, true},
+		{MaxPlayers, true},
+		{MaxPlayers + 1, false},
+		{25, false},
+	}
+	for _, test := range tests {
+		g, err := NewGame(test.numPlayers, 100, 4)
+		if (err == nil) != test.valid {
+			t.Errorf("Expected NewGame with %v players to be valid: %v, but instead it returned error %v.", test.numPlayers, test.valid, err)
+		}
+		if test.valid {
+			// Dealing a full hand to every player shouldn't run out of cards.
+			g.newRound()
+			for g.phase != Showdown {
+				if err := g.AdvancePhase(); err != nil {
+					t.Errorf("Expected a %v player hand to be dealt without error, but instead got error: %v", test.numPlayers, err)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestTableSize(t *testing.T) {
+	if _, err := NewGameWithRules(7, 100, 4, Rules{TableSize: 6}, nil); err == nil {
+		t.Errorf("Expected a game with more players than the table size to return an error, but it didn't.")
+	}
+	if _, err := NewGameWithRules(2, 100, 4, Rules{TableSize: MaxPlayers + 1}, nil); err == nil {
+		t.Errorf("Expected a table size larger than MaxPlayers to return an error, but it didn't.")
+	}
+	g, err := NewGameWithRules(5, 100, 4, Rules{TableSize: 6}, nil)
+	if err != nil {
+		t.Fatalf("Expected a game that fits the table size to be valid, but instead got error %v.", err)
+	}
+	if _, err := g.JoinTable(PlayerConfig{Stack: 100}, true); err != nil {
+		t.Errorf("Expected a player to join the last seat, but instead got error %v.", err)
+	}
+	if _, err := g.JoinTable(PlayerConfig{Stack: 100}, true); err == nil {
+		t.Errorf("Expected joining a table with every seat taken to return an error, but it didn't.")
+	}
+	other, _ := NewGameWithRules(2, 100, 4, Rules{TableSize: 6}, nil)
+	if _, err := MergeTables(&other, &g); err == nil {
+		t.Errorf("Expected merging more players than the table size to return an error, but it didn't.")
+	}
+}
+
+func TestSmallBlind(t *testing.T) {
+	tests := []struct {
+		bigBlind   int
+		smallBlind int
+		expected   int
+		valid      bool
+	}{
+		{4, 0, 2, true},
+		{5, 0, 0, false},
+		{1, 0, 0, false},
+		{5, 3, 3, true},
+		{5, 2, 2, true},
+		{10, 4, 4, true},
+		{5, 5, 0, false},
+		{5, -1, 0, false},
+	}
+	for _, test := range tests {
+		g, err := NewGameWithRules(3, 100, test.bigBlind, Rules{SmallBlind: test.smallBlind}, nil)
+		if (err == nil) != test.valid {
+			t.Errorf("Expected a small blind of %v with a big blind of %v to be valid: %v, but instead got error %v.", test.smallBlind, test.bigBlind, test.valid, err)
+			continue
+		}
+		if !test.valid {
+			continue
+		}
+		g.newRound()
+		if stack, _ := g.Stack(g.smallBlindPos); stack != 100-test.expected {
+			t.Errorf("Expected a small blind of %v to be posted with a big blind of %v, but instead %v was posted.", test.expected, test.bigBlind, 100-stack)
+		}
+	}
+}
+
+func TestNewGameWithBlinds(t *testing.T) {
+	g, err := NewGameWithBlinds(3, 100, 3, 5)
+	if err != nil {
+		t.Fatalf("Expected blinds of 3/5 to be valid, but instead got error %v.", err)
+	}
+	g.newRound()
+	if stack, _ := g.Stack(g.smallBlindPos); stack != 97 {
+		t.Errorf("Expected a small blind of 3 to be posted, but instead %v was posted.", 100-stack)
+	}
+	for _, smallBlind := range []int{0, -2, 5} {
+		if _, err := NewGameWithBlinds(3, 100, smallBlind, 5); err == nil {
+			t.Errorf("Expected a small blind of %v with a big blind of 5 to return an error, but it didn't.", smallBlind)
+		}
+	}
+}
+
+func TestActionOrder(t *testing.T) {
+	// the button acts first on every street
+	buttonFirst := func(g GameState) int { return g.ButtonPosition() }
+	g, _ := NewGameWithRules(4, 100, 4, Rules{ActionOrder: buttonFirst}, nil)
+	g.newRound()
+	if g.whoseTurn != 3 {
+		t.Errorf("Expected the button to act first preflop, but instead it was player %v's turn.", g.whoseTurn)
+	}
+	for _, action := range []func(int) error{g.Call, g.Call, g.Check, g.Call} {
+		if err := action(g.whoseTurn); err != nil {
+			t.Fatalf("Expected every player to limp, but instead got error %v.", err)
+		}
+	}
+	g.AdvancePhase()
+	if g.whoseTurn != 3 {
+		t.Errorf("Expected the button to act first on the flop, but instead it was player %v's turn.", g.whoseTurn)
+	}
+
+	// an action order that picks a player who has folded falls back to the order of Hold'em
+	g.Fold(3)
+	g.AdvancePhase()
+	if g.whoseTurn != 0 {
+		t.Errorf("Expected the first player left of the button to act first once the button folded, but instead it was player %v's turn.", g.whoseTurn)
+	}
+
+	holdem, _ := NewGame(4, 100, 4)
+	holdem.newRound()
+	if holdem.whoseTurn != 2 {
+		t.Errorf("Expected the first player left of the big blind to act first by default, but instead it was player %v's turn.", holdem.whoseTurn)
+	}
+}
+
+func TestRaiseTo(t *testing.T) {
+	tests := []struct {
+		raiseTo     int
+		valid       bool
+		expectedBet int
+	}{
+		{30, true, 30},
+		{20, true, 20},
+		// Raising to the current bet is a call.
+		{10, false, 10},
+		{5, false, 10},
+		// Less than a full raise.
+		{15, false, 10},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(3, 100, 4)
+		g.newRound()
+		g.AdvancePhase()
+		g.Bet(0, 10)
+		err := g.RaiseTo(1, test.raiseTo)
+		if (err == nil) != test.valid {
+			t.Errorf("Expected RaiseTo %v to be valid: %v, but instead it returned error %v.", test.raiseTo, test.valid, err)
+		}
+		if g.highestBetInRound != test.expectedBet || (test.valid && g.table[1].amountBetInRound != test.raiseTo) {
+			t.Errorf("Expected RaiseTo %v to make the bet %v, but instead it was %v.", test.raiseTo, test.expectedBet, g.highestBetInRound)
+		}
+	}
+}
+
+// Tests that Raise and RaiseTo reach the same bet when given an increment and the equivalent total.
+func TestRaiseByIncrementAndTotal(t *testing.T) {
+	byIncrement, _ := NewGame(3, 100, 4)
+	byIncrement.newRound()
+	byTotal := byIncrement.Clone()
+	if err := byIncrement.Raise(2, 8); err != nil {
+		t.Fatalf("Expected Raise to succeed, but instead got error: %v", err)
+	}
+	if err := byTotal.RaiseTo(2, 12); err != nil {
+		t.Fatalf("Expected RaiseTo to succeed, but instead got error: %v", err)
+	}
+	if byIncrement.highestBetInRound != 12 || byTotal.highestBetInRound != 12 {
+		t.Errorf("Expected raising by 8 and raising to 12 to both make the bet 12, but instead they made it %v and %v.",
+			byIncrement.highestBetInRound, byTotal.highestBetInRound)
+	}
+	if byIncrement.table[2].money != byTotal.table[2].money {
+		t.Errorf("Expected raising by 8 and raising to 12 to cost the same, but instead player 2 had %v and %v left.",
+			byIncrement.table[2].money, byTotal.table[2].money)
+	}
+}
+
+func TestPlayerCounts(t *testing.T) {
+	g, _ := NewGameWithRules(5, 100, 4, Rules{AllowOutOfTurnFold: true}, nil)
+	g.table[4].alive = false
+	g.newRound()
+	if g.ActivePlayerCount() != 4 || g.AlivePlayerCount() != 4 {
+		t.Errorf("Expected 4 active and 4 alive players after an elimination, but instead there were %v and %v.",
+			g.ActivePlayerCount(), g.AlivePlayerCount())
+	}
+	g.Fold(1)
+	g.Fold(3)
+	if g.ActivePlayerCount() != 2 || g.AlivePlayerCount() != 4 {
+		t.Errorf("Expected 2 active and 4 alive players after two folds, but instead there were %v and %v.",
+			g.ActivePlayerCount(), g.AlivePlayerCount())
+	}
+	g.table[0].alive = false
+	g.newRound()
+	if g.ActivePlayerCount() != 3 || g.AlivePlayerCount() != 3 {
+		t.Errorf("Expected 3 active and 3 alive players in the next round, but instead there were %v and %v.",
+			g.ActivePlayerCount(), g.AlivePlayerCount())
+	}
+}
+
+func TestCanAct(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.Fold(2)
+	g.table[0].money = 0
+	tests := []struct {
+		playerID int
+		expected bool
+	}{
+		{0, false}, // all-in
+		{1, true},
+		{2, false}, // folded
+		{3, false}, // doesn't exist
+	}
+	for _, test := range tests {
+		if got := g.CanAct(test.playerID); got != test.expected {
+			t.Errorf("Expected CanAct(%v) to return %v, but instead it returned %v.", test.playerID, test.expected, got)
+		}
+	}
+}
+
+func TestStack(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Player 0 posted the small blind and player 1 posted the big blind.
+	expected := map[int]int{0: 98, 1: 96, 2: 100}
+	for id, money := range expected {
+		stack, err := g.Stack(id)
+		if err != nil || stack != money {
+			t.Errorf("Expected player %v to have a stack of %v, but instead it was %v with error %v.", id, money, stack, err)
+		}
+	}
+	for _, id := range []int{-1, 3} {
+		if _, err := g.Stack(id); err == nil {
+			t.Errorf("Expected Stack for player %v to return an error, but it didn't.", id)
+		}
+	}
+}
+
+func TestNewRoundWithSeed(t *testing.T) {
+	a, _ := NewGame(4, 100, 4)
+	b, _ := NewGame(4, 100, 4)
+	a.newRoundWithSeed(42)
+	b.newRoundWithSeed(42)
+	if a.Seed() != 42 || b.Seed() != 42 {
+		t.Errorf("Expected both games to report seed 42, but instead they reported %v and %v.", a.Seed(), b.Seed())
+	}
+	for id := range a.table {
+		if a.table[id].hand != b.table[id].hand {
+			t.Errorf("Expected player %v to be dealt the same cards with the same seed, but instead they were dealt %v and %v.",
+				id, a.table[id].hand, b.table[id].hand)
+		}
+	}
+
+	// A random round can be reproduced from its seed.
+	a.newRound()
+	b.newRoundWithSeed(a.Seed())
+	if !cards.HandsEqual(a.deck.GetCards(), b.deck.GetCards()) {
+		t.Errorf("Expected a round started with the seed of another round to have the same deck, but it didn't.")
+	}
+}
+
+func TestDeadButton(t *testing.T) {
+	tests := []struct {
+		name       string
+		numPlayers int
+		eliminated int
+		// expected button, small blind, and big blind positions in the round after the elimination
+		expected [3]int
+		pot      int
+	}{
+		// The big blind moves on to player 2, so player 1 posts the small blind and the button is dead.
+		{"small blind busts", 4, 0, [3]int{0, 1, 2}, 6},
+		// The small blind falls to the eliminated player so only the big blind is posted.
+		{"big blind busts", 4, 1, [3]int{0, 1, 2}, 4},
+		// Once heads-up the button posts the small blind and the big blind still moves on.
+		{"small blind busts heads-up", 3, 0, [3]int{1, 1, 2}, 6},
+	}
+	for _, test := range tests {
+		g, _ := NewGameWithRules(test.numPlayers, 100, 4, Rules{DeadButton: true}, nil)
+		g.newRound()
+		g.table[test.eliminated].alive = false
+		g.newRound()
+		actual := [3]int{g.ButtonPosition(), g.smallBlindPos, g.bigBlindPos}
+		if actual != test.expected {
+			t.Errorf("%v: Expected the button, small blind, and big blind to be at %v, but instead they were at %v.",
+				test.name, test.expected, actual)
+		}
+		if g.potSize() != test.pot {
+			t.Errorf("%v: Expected %v in blinds to be posted, but instead %v was posted.", test.name, test.pot, g.potSize())
+		}
+		if committed, _ := g.CommittedThisHand(test.eliminated); committed != 0 {
+			t.Errorf("%v: Expected eliminated player %v not to post a blind, but they did.", test.name, test.eliminated)
+		}
+	}
+}
+
+func TestUnseenCards(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.AdvancePhase()
+	unseen := g.UnseenCards(0)
+	if len(unseen) != 52-2-3 {
+		t.Errorf("Expected 47 unseen cards on the flop, but instead there were %v.", len(unseen))
+	}
+	visible := append([]cards.Card{g.table[0].hand[0], g.table[0].hand[1]}, g.community...)
+	for _, c := range visible {
+		if cardInSlice(c, unseen) {
+			t.Errorf("Expected %v to be visible to player 0, but it was unseen.", c)
+		}
+	}
+	// Other players' hole cards can't be seen until they are shown.
+	if !cardInSlice(g.table[1].hand[0], unseen) || !cardInSlice(g.table[1].hand[1], unseen) {
+		t.Errorf("Expected player 1's hole cards to be unseen by player 0, but they weren't.")
+	}
+	g.ShowCard(1, 1)
+	unseen = g.UnseenCards(0)
+	if len(unseen) != 46 || !cardInSlice(g.table[1].hand[0], unseen) || cardInSlice(g.table[1].hand[1], unseen) {
+		t.Errorf("Expected only player 1's shown card to become visible to player 0, but it didn't.")
+	}
+	if unseen := g.UnseenCards(3); len(unseen) != 0 {
+		t.Errorf("Expected no unseen cards for a player who doesn't exist, but instead there were %v.", len(unseen))
+	}
+}
+
+func TestPotByStreet(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Everyone calls the big blind, which checks.
+	g.Call(2)
+	g.Call(0)
+	g.Check(1)
+	g.AdvancePhase()
+	g.Bet(0, 10)
+	g.Call(1)
+	g.Call(2)
+	g.AdvancePhase()
+	g.Check(0)
+	g.Check(1)
+	g.Check(2)
+	g.AdvancePhase()
+	g.Bet(0, 20)
+	g.Fold(1)
+	g.Call(2)
+	g.DistributePot()
+
+	expected := map[Phase]int{PreFlop: 12, Flop: 42, Turn: 42, River: 82}
+	pots := g.PotByStreet()
+	if len(pots) != len(expected) {
+		t.Errorf("Expected the pot to be recorded for %v streets, but instead it was recorded for %v.", len(expected), pots)
+	}
+	for phase, amount := range expected {
+		if pots[phase] != amount {
+			t.Errorf("Expected the pot to be %v at the end of the %v, but instead it was %v.", amount, phase, pots[phase])
+		}
+	}
+
+	g.newRound()
+	if len(g.PotByStreet()) != 0 {
+		t.Errorf("Expected the pot history to reset in a new round, but instead it was %v.", g.PotByStreet())
+	}
+}
+
+func TestSPR(t *testing.T) {
+	tests := []struct {
+		money    map[int]int
+		playerID int
+		expected float64
+	}{
+		// The pot has the blinds of 6.
+		{map[int]int{0: 98, 1: 96, 2: 60}, 2, 10},
+		// The effective stack is against the deepest opponent, capped by the player's own stack.
+		{map[int]int{0: 30, 1: 24, 2: 300}, 2, 5},
+		{map[int]int{0: 30, 1: 24, 2: 300}, 1, 4},
+		{map[int]int{0: 3, 1: 96, 2: 100}, 0, 0.5},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(3, 100, 4)
+		g.newRound()
+		for id, money := range test.money {
+			g.table[id].money = money
+		}
+		if spr := g.SPR(test.playerID); spr != test.expected {
+			t.Errorf("Expected player %v to have an SPR of %v with stacks %v, but instead it was %v.", test.playerID, test.expected, test.money, spr)
+		}
+	}
+
+	g, _ := NewGame(3, 100, 4)
+	if spr := g.SPR(0); spr != -1 {
+		t.Errorf("Expected the SPR with an empty pot to be -1, but instead it was %v.", spr)
+	}
+	g.newRound()
+	if spr := g.SPR(3); spr != -1 {
+		t.Errorf("Expected the SPR of a player who doesn't exist to be -1, but instead it was %v.", spr)
+	}
+}
+
+func TestMinimumBetsByStreet(t *testing.T) {
+	tests := []struct {
+		rules    Rules
+		phase    Phase
+		bet      int
+		validBet bool
+	}{
+		{Rules{MinimumBets: map[Phase]int{Turn: 10, River: 10}}, Flop, 4, true},
+		{Rules{MinimumBets: map[Phase]int{Turn: 10, River: 10}}, Turn, 8, false},
+		{Rules{MinimumBets: map[Phase]int{Turn: 10, River: 10}}, Turn, 10, true},
+		{Rules{MinimumBets: map[Phase]int{Turn: 10, River: 10}}, River, 25, true},
+		{Rules{Betting: FixedLimit, MinimumBets: map[Phase]int{River: 12}}, Turn, 8, true},
+		{Rules{Betting: FixedLimit, MinimumBets: map[Phase]int{River: 12}}, River, 8, false},
+		{Rules{Betting: FixedLimit, MinimumBets: map[Phase]int{River: 12}}, River, 12, true},
+	}
+	for _, test := range tests {
+		g, _ := NewGameWithRules(3, 100, 4, test.rules, nil)
+		g.newRound()
+		for g.phase < test.phase {
+			g.AdvancePhase()
+		}
+		err := g.Bet(0, test.bet)
+		if (err == nil) != test.validBet {
+			t.Errorf("Expected betting %v on the %v with rules %+v to be valid: %v, but the error was %v.",
+				test.bet, test.phase, test.rules, test.validBet, err)
+		}
+	}
+}
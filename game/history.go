@@ -0,0 +1,140 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// handHistory records what happened in the current hand for ExportHandHistory.
+type handHistory struct {
+	stacks   map[int]int // stack of each player dealt into the hand, before the blinds
+	actions  []loggedAction
+	winnings map[int]int // amount won by each player, nil until the pot is distributed
+	rake     int
+}
+
+// loggedAction is a blind posted or an action taken during a hand.
+type loggedAction struct {
+	phase    Phase
+	playerID int
+	kind     string // "post" for a blind, otherwise the name of the ActionType
+	chips    int    // chips the player put in
+}
+
+// Returns a copy of the history that can be appended to without changing the original.
+func (h handHistory) clone() handHistory {
+	clone := handHistory{make(map[int]int), append([]loggedAction{}, h.actions...), nil, h.rake}
+	for id, stack := range h.stacks {
+		clone.stacks[id] = stack
+	}
+	if h.winnings != nil {
+		clone.winnings = make(map[int]int)
+		for id, amount := range h.winnings {
+			clone.winnings[id] = amount
+		}
+	}
+	return clone
+}
+
+// Adds a blind or action to the history of the current hand.
+func (g *GameState) logAction(playerID int, kind string, chips int) {
+	g.history.actions = append(g.history.actions, loggedAction{g.phase, playerID, kind, chips})
+}
+
+// ExportHandHistory returns a text record of the most recent hand, once its pot has been distributed.
+// Each line is a keyword followed by values separated by spaces, with cards in the notation of
+// cards.ParseCard:
+//
+//	hand <number>                  the number of the hand in the game, starting at 1
+//	seed <seed>                    the seed the deck was shuffled with
+//	button <id>                    the player on the dealer button
+//	stack <id> <chips>             for each player dealt in, their stack before the blinds
+//	preflop                        followed by the blinds and preflop actions
+//	flop <card> <card> <card>      followed by the flop actions, and likewise for the turn and river
+//	turn <card>
+//	river <card>
+//	post <id> <chips>              a blind, including one posted to join the table
+//	fold <id>                      also check, and call, bet, or raise followed by the chips put in
+//	showdown                       only if more than one player was left
+//	show <id> <card> <card>        the hole cards of each player at the showdown
+//	win <id> <chips>               the amount won by each winner
+//	rake <chips>                   only if rake was taken
+//
+// It returns an error if no hand has been played or the pot of the current hand hasn't been distributed.
+func (g GameState) ExportHandHistory() (string, error) {
+	if g.handsPlayed == 0 || g.history.winnings == nil {
+		return "", errors.New("error exporting hand history: the hand isn't over")
+	}
+	lines := []string{
+		fmt.Sprintf("hand %v", g.handsPlayed),
+		fmt.Sprintf("seed %v", g.seed),
+		fmt.Sprintf("button %v", g.buttonPos),
+	}
+	for _, id := range sortedIDs(g.history.stacks) {
+		lines = append(lines, fmt.Sprintf("stack %v %v", id, g.history.stacks[id]))
+	}
+	streets := []struct {
+		phase Phase
+		name  string
+		from  int // index of the street's first community card
+		to    int
+	}{{PreFlop, "preflop", 0, 0}, {Flop, "flop", 0, 3}, {Turn, "turn", 3, 4}, {River, "river", 4, 5}}
+	for _, street := range streets {
+		if len(g.community) < street.to {
+			break
+		}
+		header := street.name
+		if street.to > 0 {
+			header += " " + cardsNotation(g.community[street.from:street.to])
+		}
+		lines = append(lines, header)
+		for _, a := range g.history.actions {
+			if a.phase != street.phase {
+				continue
+			}
+			switch a.kind {
+			case FoldAction.String(), CheckAction.String():
+				lines = append(lines, fmt.Sprintf("%v %v", a.kind, a.playerID))
+			default:
+				lines = append(lines, fmt.Sprintf("%v %v %v", a.kind, a.playerID, a.chips))
+			}
+		}
+	}
+	if len(g.participating) > 1 {
+		lines = append(lines, "showdown")
+		for _, id := range g.participating {
+			hole := g.table[id].hand
+			lines = append(lines, fmt.Sprintf("show %v %v", id, cardsNotation(hole[:])))
+		}
+	}
+	for _, id := range sortedIDs(g.history.winnings) {
+		lines = append(lines, fmt.Sprintf("win %v %v", id, g.history.winnings[id]))
+	}
+	if g.history.rake > 0 {
+		lines = append(lines, fmt.Sprintf("rake %v", g.history.rake))
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// Returns the cards in the notation of cards.ParseCard, separated by spaces, in the order they are in.
+func cardsNotation(cs []cards.Card) string {
+	notations := []string{}
+	for _, c := range cs {
+		notations = append(notations, cards.Hand{c}.String())
+	}
+	return strings.Join(notations, " ")
+}
+
+// Returns the player ids of the map in increasing order.
+func sortedIDs(byID map[int]int) []int {
+	ids := []int{}
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
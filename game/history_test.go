@@ -0,0 +1,55 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportHandHistory(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRoundWithSeed(7)
+	if _, err := g.ExportHandHistory(); err == nil {
+		t.Errorf("Expected exporting a hand that isn't over to return an error, but it didn't.")
+	}
+	g.Raise(2, 8)
+	g.Call(0)
+	g.Fold(1)
+	g.AdvancePhase()
+	g.Check(0)
+	g.Bet(2, 10)
+	g.Call(0)
+	flop := cardsNotation(g.community)
+	hole0, hole2 := cardsNotation(g.table[0].hand[:]), cardsNotation(g.table[2].hand[:])
+	result, err := g.RunToShowdown(map[int]PlayerAgent{0: passiveAgent, 2: passiveAgent})
+	if err != nil {
+		t.Fatalf("Expected the hand to be played out, but instead got error %v.", err)
+	}
+
+	history, err := g.ExportHandHistory()
+	if err != nil {
+		t.Fatalf("Expected exporting a finished hand not to return an error, but it returned %v.", err)
+	}
+	lines := strings.Split(strings.TrimSpace(history), "\n")
+	expected := []string{
+		"hand 1", "seed 7", "button 2", "stack 0 100", "stack 1 100", "stack 2 100",
+		"preflop", "post 0 2", "post 1 4", "raise 2 12", "call 0 10", "fold 1",
+		"flop " + flop, "check 0", "bet 2 10", "call 0 10",
+		"turn " + cardsNotation(g.community[3:4]), "check 0", "check 2",
+		"river " + cardsNotation(g.community[4:5]), "check 0", "check 2",
+		"showdown", "show 0 " + hole0, "show 2 " + hole2,
+	}
+	for i, line := range expected {
+		if i >= len(lines) || lines[i] != line {
+			t.Fatalf("Expected line %v of the history to be %q, but instead the history was:\n%v", i+1, line, history)
+		}
+	}
+	wins := lines[len(expected):]
+	if len(wins) != len(result.Winnings) {
+		t.Errorf("Expected a win line for each of the %v winners, but instead the history ended with %v.", len(result.Winnings), wins)
+	}
+	for _, line := range wins {
+		if !strings.HasPrefix(line, "win ") {
+			t.Errorf("Expected the history to end with the winners, but instead it had %q.", line)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package game
+
+// ICMEquity returns each player's expected prize money under the Independent Chip Model, given the chip
+// stacks of the remaining players and the payouts for first place, second place, and so on. The chance of
+// a player finishing first is their share of the chips, and the chance of them finishing in each later
+// place is worked out the same way from the chips of the players who haven't finished above them. Players
+// without chips finish last, and payouts for places beyond the number of players are ignored.
+func ICMEquity(stacks []int, payouts []int) []float64 {
+	equity := make([]float64, len(stacks))
+	total := 0
+	for _, stack := range stacks {
+		total += stack
+	}
+	if total > 0 {
+		addICMEquity(stacks, payouts, total, 1, make([]bool, len(stacks)), equity)
+	}
+	return equity
+}
+
+// Adds the expected winnings of every player who hasn't finished to their equity, for the place paid the
+// first of the payouts and each place after it, given the probability of the players who have finished
+// finishing in the order they did and the chips of the players who haven't.
+func addICMEquity(stacks []int, payouts []int, remaining int, probability float64, finished []bool, equity []float64) {
+	if len(payouts) == 0 || remaining == 0 {
+		return
+	}
+	for id, stack := range stacks {
+		if finished[id] || stack == 0 {
+			continue
+		}
+		p := probability * float64(stack) / float64(remaining)
+		equity[id] += p * float64(payouts[0])
+		finished[id] = true
+		addICMEquity(stacks, payouts[1:], remaining-stack, p, finished, equity)
+		finished[id] = false
+	}
+}
@@ -0,0 +1,29 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+func TestICMEquity(t *testing.T) {
+	tests := []struct {
+		name     string
+		stacks   []int
+		payouts  []int
+		expected []float64
+	}{
+		{"three players, two payouts", []int{50, 30, 20}, []int{70, 30}, []float64{45.18, 32.25, 22.57}},
+		{"equal stacks", []int{1000, 1000, 1000}, []int{50, 30, 20}, []float64{33.33, 33.33, 33.33}},
+		{"heads-up", []int{3000, 1000}, []int{60, 40}, []float64{55, 45}},
+		{"busted player", []int{600, 0, 400}, []int{70, 30}, []float64{54, 0, 46}},
+		{"more payouts than players", []int{10, 10}, []int{50, 30, 20}, []float64{40, 40}},
+	}
+	for _, test := range tests {
+		equity := ICMEquity(test.stacks, test.payouts)
+		for id, expected := range test.expected {
+			if math.Abs(equity[id]-expected) > 0.01 {
+				t.Errorf("%v: Expected player %v to have equity of %v, but instead it was %v.", test.name, id, expected, equity[id])
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// rotation is the rule sets of a mixed game and how many hands are played with each.
+type rotation struct {
+	variants      []Rules
+	handsPerRules int
+	firstHand     int // number of hands that had been played when the rotation was set
+}
+
+// SetRotation makes the game a mixed game that switches between the rule sets, playing the specified
+// number of hands with each before moving on to the next and starting over after the last. Every rule
+// set is dealt as Hold'em, so a rotation can mix variants such as hi-lo, limit, and pot-limit Hold'em.
+// The rotation starts with the first rule set from the next hand on, and each hand is played with the
+// showdown mode, betting structure, and small blind of its rule set, unless the game has a blind schedule,
+// whose levels set both blinds. The game keeps its mode, so a tournament table stays a tournament table.
+// It returns an error if there are no rule sets, the number of hands isn't positive, or a rule set can't
+// be played by the game, such as one with a table size smaller than the table or a small blind that
+// doesn't fit the big blind or the big blind of a level of the blind schedule.
+func (g *GameState) SetRotation(variants []Rules, handsPerRules int) error {
+	if len(variants) == 0 {
+		return errors.New("error setting rotation: there must be at least one rule set")
+	}
+	if handsPerRules <= 0 {
+		return fmt.Errorf("error setting rotation: must play at least 1 hand with each rule set, not %v", handsPerRules)
+	}
+	for i, rules := range variants {
+		if err := rules.validate(); err != nil {
+			return fmt.Errorf("error setting rotation: rule set %v: %v", i+1, err)
+		}
+		if rules.TableSize != 0 && rules.TableSize < len(g.table) {
+			return fmt.Errorf("error setting rotation: rule set %v has a table size of %v, smaller than the table of %v seats", i+1, rules.TableSize, len(g.table))
+		}
+		for _, bigBlind := range g.bigBlinds() {
+			if _, err := rules.smallBlindFor(bigBlind); err != nil {
+				return fmt.Errorf("error setting rotation: rule set %v: %v", i+1, err)
+			}
+		}
+	}
+	g.rotation = rotation{append([]Rules{}, variants...), handsPerRules, g.handsPlayed}
+	return nil
+}
+
+// Switches to the rule set of the rotation for the hand being started, if the game has a rotation.
+// It must be called before the hand is counted in handsPlayed.
+func (g *GameState) applyRotation() {
+	if len(g.rotation.variants) == 0 {
+		return
+	}
+	hand := g.handsPlayed - g.rotation.firstHand
+	rules := g.rotation.variants[(hand/g.rotation.handsPerRules)%len(g.rotation.variants)]
+	rules.Mode = g.rules.Mode
+	g.rules = rules
+	// SetRotation and SetBlindSchedule make sure every rule set has a small blind at each big blind the
+	// game is played at, and a blind schedule then sets the small blind of its level as well
+	g.smallBlindAmount, _ = rules.smallBlindFor(g.bigBlindAmount)
+}
+
+// Returns the big blinds the game can be played at: the current big blind and the big blinds of the
+// levels of the blind schedule.
+func (g GameState) bigBlinds() []int {
+	bigBlinds := []int{g.bigBlindAmount}
+	for _, level := range g.clock.schedule {
+		bigBlinds = append(bigBlinds, level.BigBlind)
+	}
+	return bigBlinds
+}
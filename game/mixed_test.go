@@ -0,0 +1,93 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetRotation(t *testing.T) {
+	g, _ := NewGame(3, 1000, 4)
+	limitHoldem := Rules{Betting: FixedLimit, SmallBlind: 1}
+	potLimitHiLo := Rules{ShowdownMode: HiLo, Betting: PotLimit}
+	if err := g.SetRotation([]Rules{limitHoldem, potLimitHiLo}, 2); err != nil {
+		t.Fatalf("Expected the rotation to be valid, but instead got error %v.", err)
+	}
+	expected := []Rules{limitHoldem, limitHoldem, potLimitHiLo, potLimitHiLo, limitHoldem}
+	for hand, rules := range expected {
+		g.newRound()
+		if g.rules.Betting != rules.Betting || g.rules.ShowdownMode != rules.ShowdownMode {
+			t.Errorf("Expected hand %v to be played with %+v, but instead it was played with %+v.", hand+1, rules, g.rules)
+		}
+		// the first player to act can only raise by exactly the big blind in fixed limit
+		min, max := g.RaiseRange(g.whoseTurn)
+		if limit := rules.Betting == FixedLimit; limit != (min == max) {
+			t.Errorf("Expected hand %v to allow raises from %v to %v to match its betting structure %v.", hand+1, min, max, rules.Betting)
+		}
+		smallBlind := 2
+		if rules.SmallBlind != 0 {
+			smallBlind = rules.SmallBlind
+		}
+		if posted, _ := g.CommittedThisHand(g.smallBlindPos); posted != smallBlind {
+			t.Errorf("Expected hand %v to post a small blind of %v, but instead %v was posted.", hand+1, smallBlind, posted)
+		}
+		// player 0 has the only low hand, which only wins half the pot in hi-lo
+		g.community = parseCards("Ah 2c 3d Kh Ks")
+		for id, hole := range []string{"4s 5s", "Kd Qc", "9h 9c"} {
+			copy(g.table[id].hand[:], parseCards(hole))
+		}
+		result, _ := g.DistributePot()
+		if hiLo := rules.ShowdownMode == HiLo; hiLo != (len(result.Pots[0].LowWinners) == 1) {
+			t.Errorf("Expected hand %v to award a low half to match its showdown mode %v, but instead the low winners were %v.",
+				hand+1, rules.ShowdownMode, result.Pots[0].LowWinners)
+		}
+	}
+}
+
+func TestSetRotationKeepsMode(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	NewTournament().RegisterTable(&g)
+	g.SetRotation([]Rules{{Betting: PotLimit}}, 1)
+	g.newRound()
+	if g.Mode() != TournamentGame {
+		t.Errorf("Expected a tournament table to stay a tournament in a rotation, but instead its mode was %v.", g.Mode())
+	}
+}
+
+func TestSetRotationInvalid(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	tests := []struct {
+		name          string
+		variants      []Rules
+		handsPerRules int
+	}{
+		{"no rule sets", []Rules{}, 1},
+		{"no hands", []Rules{{}}, 0},
+		{"table too small", []Rules{{}, {TableSize: 2}}, 1},
+		{"small blind of the big blind", []Rules{{SmallBlind: 4}}, 1},
+		{"minimum bet of nothing", []Rules{{Betting: FixedLimit, MinimumBets: map[Phase]int{Turn: 0}}}, 1},
+		{"minimum bet at showdown", []Rules{{MinimumBets: map[Phase]int{Showdown: 8}}}, 1},
+		{"unknown betting structure", []Rules{{Betting: 3}}, 1},
+	}
+	for _, test := range tests {
+		if err := g.SetRotation(test.variants, test.handsPerRules); err == nil {
+			t.Errorf("%v: Expected SetRotation to return an error, but it didn't.", test.name)
+		}
+	}
+}
+
+func TestSetRotationBlindSchedule(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.SetBlindSchedule([]BlindLevel{{2, 4, 10 * time.Minute}, {2, 5, 10 * time.Minute}})
+	if err := g.SetRotation([]Rules{{}}, 1); err == nil {
+		t.Errorf("Expected a rule set without a small blind for a scheduled big blind of 5 to return an error, but it didn't.")
+	}
+	if err := g.SetRotation([]Rules{{SmallBlind: 1}}, 1); err != nil {
+		t.Errorf("Expected a rule set with a small blind of 1 to be valid, but instead got error %v.", err)
+	}
+
+	g, _ = NewGame(3, 100, 4)
+	g.SetRotation([]Rules{{}}, 1)
+	if err := g.SetBlindSchedule([]BlindLevel{{2, 5, 10 * time.Minute}}); err == nil {
+		t.Errorf("Expected a big blind of 5 without a small blind in the rotation to return an error, but it didn't.")
+	}
+}
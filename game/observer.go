@@ -0,0 +1,94 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// godViewID is the viewer id of a view that sees every player's hole cards.
+const godViewID = -1
+
+// PlayerView is the part of the game state that a viewer can see.
+type PlayerView struct {
+	Viewer    int // id of the player the view belongs to, -1 for a god view
+	Phase     Phase
+	WhoseTurn int
+	Pot       int
+	Community []cards.Card
+	Stacks    map[int]int          // money each player has left, by player id
+	HoleCards map[int][]cards.Card // hole cards the viewer can see, by player id
+}
+
+// Observer is sent a view of the game whenever the game state changes.
+type Observer interface {
+	Update(view PlayerView)
+}
+
+// observer is a registered Observer along with whose view it is sent.
+type observer struct {
+	viewer int
+	o      Observer
+}
+
+// ViewFor returns what the specified player can see: their own hole cards, any cards that other players
+// have shown, and everything that is public.
+func (g GameState) ViewFor(playerID int) (PlayerView, error) {
+	if g.getTablePos(playerID) == -1 {
+		return PlayerView{}, fmt.Errorf("there is no player with id %v", playerID)
+	}
+	return g.view(playerID), nil
+}
+
+// GodView returns a view that sees every player's hole cards, for training and analysis tools. It must
+// never be sent to a player.
+func (g GameState) GodView() PlayerView {
+	return g.view(godViewID)
+}
+
+// AddObserver registers an observer that is sent the specified player's view whenever the game changes.
+func (g *GameState) AddObserver(playerID int, o Observer) error {
+	if g.getTablePos(playerID) == -1 {
+		return fmt.Errorf("error adding observer: there is no player with id %v", playerID)
+	}
+	g.observers = append(g.observers, observer{playerID, o})
+	return nil
+}
+
+// AddGodViewObserver registers an observer that is sent the god view, which sees every player's hole
+// cards, whenever the game changes. It is only meant for training and analysis tools.
+func (g *GameState) AddGodViewObserver(o Observer) {
+	g.observers = append(g.observers, observer{godViewID, o})
+}
+
+// Sends each observer their view of the game.
+func (g GameState) notifyObservers() {
+	for _, obs := range g.observers {
+		obs.o.Update(g.view(obs.viewer))
+	}
+}
+
+// Returns the view of the specified viewer, who sees every hole card if they are the god view.
+func (g GameState) view(viewer int) PlayerView {
+	view := PlayerView{
+		Viewer:    viewer,
+		Phase:     g.phase,
+		WhoseTurn: g.whoseTurn,
+		Pot:       g.potSize(),
+		Community: append([]cards.Card{}, g.community...),
+		Stacks:    make(map[int]int),
+		HoleCards: make(map[int][]cards.Card),
+	}
+	for _, p := range g.table {
+		view.Stacks[p.id] = p.money
+		if !p.alive {
+			continue
+		}
+		if viewer == godViewID || viewer == p.id {
+			view.HoleCards[p.id] = []cards.Card{p.hand[0], p.hand[1]}
+		} else if shown, _ := g.ShownCards(p.id); len(shown) > 0 {
+			view.HoleCards[p.id] = shown
+		}
+	}
+	return view
+}
@@ -0,0 +1,74 @@
+package game
+
+import "testing"
+
+// Records every view it is sent.
+type recordingObserver struct {
+	views []PlayerView
+}
+
+func (r *recordingObserver) Update(view PlayerView) {
+	r.views = append(r.views, view)
+}
+
+func TestGodViewObserver(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	god := &recordingObserver{}
+	player := &recordingObserver{}
+	g.AddGodViewObserver(god)
+	g.AddObserver(1, player)
+	g.newRound()
+	g.Call(2)
+
+	if len(god.views) != 2 || len(player.views) != 2 {
+		t.Fatalf("Expected both observers to be sent a view for the new round and the call, but they were sent %v and %v.",
+			len(god.views), len(player.views))
+	}
+	godView, playerView := god.views[1], player.views[1]
+	for _, p := range g.table {
+		if hole := godView.HoleCards[p.id]; len(hole) != 2 || hole[0] != p.hand[0] || hole[1] != p.hand[1] {
+			t.Errorf("Expected the god view to see player %v's hole cards %v, but instead it saw %v.", p.id, p.hand, hole)
+		}
+	}
+	if len(playerView.HoleCards) != 1 || len(playerView.HoleCards[1]) != 2 {
+		t.Errorf("Expected player 1's view to only see their own hole cards, but instead it saw %v.", playerView.HoleCards)
+	}
+	if godView.Pot != 10 || playerView.Pot != 10 || playerView.Stacks[2] != 96 {
+		t.Errorf("Expected both views to see the pot of 10 and player 2's stack of 96, but instead they saw %+v and %+v.", godView, playerView)
+	}
+
+	// Shown cards become visible to everyone.
+	g.ShowCard(0, 1)
+	playerView = player.views[len(player.views)-1]
+	if shown := playerView.HoleCards[0]; len(shown) != 1 || shown[0] != g.table[0].hand[1] {
+		t.Errorf("Expected player 1 to see the card player 0 showed, but instead they saw %v.", shown)
+	}
+
+	// Changes to a clone aren't sent to the original's observers.
+	clone := g.Clone()
+	clone.Call(0)
+	if len(god.views) != 3 {
+		t.Errorf("Expected observers not to be sent views for changes to a clone, but the god view was sent %v views.", len(god.views))
+	}
+}
+
+func TestViewFor(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	view, err := g.ViewFor(2)
+	if err != nil {
+		t.Fatalf("Expected ViewFor(2) not to return an error, but it returned %v.", err)
+	}
+	if view.Viewer != 2 || len(view.HoleCards) != 1 || view.HoleCards[2][0] != g.table[2].hand[0] {
+		t.Errorf("Expected player 2's view to only contain their own hole cards, but instead it was %+v.", view)
+	}
+	if _, err := g.ViewFor(3); err == nil {
+		t.Errorf("Expected ViewFor a player who doesn't exist to return an error, but it didn't.")
+	}
+	if err := g.AddObserver(3, &recordingObserver{}); err == nil {
+		t.Errorf("Expected adding an observer for a player who doesn't exist to return an error, but it didn't.")
+	}
+	if view := g.GodView(); view.Viewer != godViewID || len(view.HoleCards) != 3 {
+		t.Errorf("Expected the god view to see all 3 players' hole cards, but instead it was %+v.", view)
+	}
+}
@@ -0,0 +1,121 @@
+package game
+
+import "sort"
+
+// Pot holds the chips put in during a hand along with how much each player contributed, which
+// determines the side pots each player is eligible to win.
+type Pot struct {
+	contributions map[int]int // amount put in by each player this hand, by player id
+	total         int
+}
+
+// SidePot is an amount of money from the pot that can only be won by the eligible players.
+type SidePot struct {
+	Amount   int
+	Eligible []int // ids of the players who can win the side pot
+}
+
+// NewPot creates an empty pot.
+func NewPot() Pot {
+	return Pot{make(map[int]int), 0}
+}
+
+// Add puts the specified amount of the player's money into the pot.
+func (p *Pot) Add(playerID int, amount int) {
+	p.contributions[playerID] += amount
+	p.total += amount
+}
+
+// Total returns the amount of money in the pot.
+func (p Pot) Total() int {
+	return p.total
+}
+
+// Contribution returns the amount the specified player has put into the pot.
+func (p Pot) Contribution(playerID int) int {
+	return p.contributions[playerID]
+}
+
+// SidePots splits the pot into the main pot followed by any side pots. The contenders are the players
+// still able to win the pot, each of whom is only eligible to win from every other player up to the
+// amount they contributed themselves. Chips contributed by players who aren't contenders are added to
+// the pots but those players aren't eligible to win them.
+func (p Pot) SidePots(contenders []int) []SidePot {
+	levels := []int{}
+	for _, id := range contenders {
+		contributed := p.contributions[id]
+		if !intInSlice(contributed, levels) {
+			levels = append(levels, contributed)
+		}
+	}
+	sort.Ints(levels)
+
+	pots := []SidePot{}
+	prevLevel := 0
+	for _, level := range levels {
+		pot := SidePot{0, []int{}}
+		for _, contributed := range p.contributions {
+			pot.Amount += minInt(contributed, level) - minInt(contributed, prevLevel)
+		}
+		for _, id := range contenders {
+			if p.contributions[id] >= level {
+				pot.Eligible = append(pot.Eligible, id)
+			}
+		}
+		sort.Ints(pot.Eligible)
+		if pot.Amount > 0 {
+			pots = append(pots, pot)
+		}
+		prevLevel = level
+	}
+
+	// Players who aren't contenders may have contributed more than any contender, which goes to the last pot.
+	extra := 0
+	for _, contributed := range p.contributions {
+		extra += contributed - minInt(contributed, prevLevel)
+	}
+	if extra > 0 {
+		if len(pots) == 0 {
+			eligible := append([]int{}, contenders...)
+			sort.Ints(eligible)
+			pots = append(pots, SidePot{0, eligible})
+		}
+		pots[len(pots)-1].Amount += extra
+	}
+	return pots
+}
+
+// EligiblePlayers returns the ids of the players who can win the pot at the specified index of the
+// main pot, 0, followed by the side pots. The pots are formed from everything put in so far this hand,
+// including bets of the current betting round, among the players who haven't folded. It returns an
+// empty slice if there is no pot at the index.
+func (g GameState) EligiblePlayers(potIndex int) []int {
+	pot := g.pot.clone()
+	for _, p := range g.table {
+		if p.amountBetInRound > 0 {
+			pot.Add(p.id, p.amountBetInRound)
+		}
+	}
+	pots := pot.SidePots(g.participating)
+	if potIndex < 0 || potIndex >= len(pots) {
+		return []int{}
+	}
+	return pots[potIndex].Eligible
+}
+
+// Returns a copy of the pot that doesn't share contributions with the original.
+func (p Pot) clone() Pot {
+	clone := NewPot()
+	for id, amount := range p.contributions {
+		clone.contributions[id] = amount
+	}
+	clone.total = p.total
+	return clone
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,74 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPotAdd(t *testing.T) {
+	pot := NewPot()
+	pot.Add(0, 10)
+	pot.Add(1, 25)
+	pot.Add(0, 15)
+	if pot.Total() != 50 {
+		t.Errorf("Expected the pot total to be 50, but instead it was %v.", pot.Total())
+	}
+	expected := map[int]int{0: 25, 1: 25, 2: 0}
+	for id, amount := range expected {
+		if pot.Contribution(id) != amount {
+			t.Errorf("Expected player %v to have contributed %v, but instead they contributed %v.", id, amount, pot.Contribution(id))
+		}
+	}
+}
+
+func TestPotSidePots(t *testing.T) {
+	tests := []struct {
+		contributions map[int]int
+		contenders    []int
+		expected      []SidePot
+	}{
+		// Everyone contributed the same amount so there is only a main pot.
+		{map[int]int{0: 20, 1: 20, 2: 20}, []int{0, 1, 2}, []SidePot{{60, []int{0, 1, 2}}}},
+		// Player 0 is all-in for less, and player 2 folded after putting in 10.
+		{map[int]int{0: 30, 1: 50, 2: 10, 3: 50}, []int{3, 1, 0}, []SidePot{{100, []int{0, 1, 3}}, {40, []int{1, 3}}}},
+		// Three different all-in amounts.
+		{map[int]int{0: 10, 1: 20, 2: 40}, []int{0, 1, 2}, []SidePot{{30, []int{0, 1, 2}}, {20, []int{1, 2}}, {20, []int{2}}}},
+		// The folded player put in more than anyone still contending, which goes to the last pot.
+		{map[int]int{0: 10, 1: 10, 2: 30}, []int{0, 1}, []SidePot{{50, []int{0, 1}}}},
+	}
+	for _, test := range tests {
+		pot := NewPot()
+		for id, amount := range test.contributions {
+			pot.Add(id, amount)
+		}
+		actual := pot.SidePots(test.contenders)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected the pot with contributions %v to be split into %v, but instead it was split into %v.",
+				test.contributions, test.expected, actual)
+		}
+	}
+}
+
+func TestEligiblePlayers(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.table[0].money = 30
+	g.newRound()
+	g.RaiseTo(2, 50)
+	g.Call(0)
+	g.Call(1)
+	// Player 0 is all-in for 30, so they can only win the main pot.
+	tests := []struct {
+		potIndex int
+		expected []int
+	}{
+		{0, []int{0, 1, 2}},
+		{1, []int{1, 2}},
+		{2, []int{}},
+		{-1, []int{}},
+	}
+	for _, test := range tests {
+		if eligible := g.EligiblePlayers(test.potIndex); !intSlicesEqual(eligible, test.expected) {
+			t.Errorf("Expected players %v to be eligible for pot %v, but instead it was %v.", test.expected, test.potIndex, eligible)
+		}
+	}
+}
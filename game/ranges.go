@@ -0,0 +1,115 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+var allSuits = []cards.Suit{cards.Spade, cards.Club, cards.Heart, cards.Diamond}
+
+// ParseRange expands a comma separated range of hands in standard notation into every hole card
+// combination in the range. Supported hands are pairs (Ex. "TT"), suited hands (Ex. "AKs"), offsuit
+// hands (Ex. "AKo"), and hands that are either suited or offsuit (Ex. "AK"). A trailing "+" includes
+// every higher pair, or for unpaired hands every higher kicker below the top card (Ex. "ATs+" is
+// ATs, AJs, AQs, and AKs). Combinations appearing more than once in the range are only returned once.
+func ParseRange(s string) ([][2]cards.Card, error) {
+	combos := [][2]cards.Card{}
+	seen := make(map[[2]cards.Card]bool)
+	for _, token := range strings.Split(s, ",") {
+		hands, err := parseRangeToken(strings.TrimSpace(token))
+		if err != nil {
+			return [][2]cards.Card{}, fmt.Errorf("error parsing range %q: %v", s, err)
+		}
+		for _, h := range hands {
+			if !seen[h] {
+				seen[h] = true
+				combos = append(combos, h)
+			}
+		}
+	}
+	return combos, nil
+}
+
+// parseRangeToken returns the combinations described by a single hand of range notation such as "QJo+".
+func parseRangeToken(token string) ([][2]cards.Card, error) {
+	hand := strings.TrimSuffix(token, "+")
+	plus := hand != token
+	if len(hand) < 2 || len(hand) > 3 {
+		return [][2]cards.Card{}, fmt.Errorf("invalid hand %q", token)
+	}
+	high, highErr := cards.ParseRank(hand[:1])
+	low, lowErr := cards.ParseRank(hand[1:2])
+	if highErr != nil || lowErr != nil {
+		return [][2]cards.Card{}, fmt.Errorf("invalid rank in hand %q", token)
+	}
+	suitedness := hand[2:]
+	if suitedness != "" && suitedness != "s" && suitedness != "o" {
+		return [][2]cards.Card{}, fmt.Errorf("invalid suitedness %q in hand %q, must be s or o", suitedness, token)
+	}
+
+	combos := [][2]cards.Card{}
+	if high == low {
+		if suitedness != "" {
+			return [][2]cards.Card{}, fmt.Errorf("pair %q cannot be suited or offsuit", token)
+		}
+		top := high
+		if plus {
+			top = cards.Ace
+		}
+		for r := high; r <= top; r++ {
+			combos = append(combos, pairCombos(r)...)
+		}
+		return combos, nil
+	}
+
+	if low > high {
+		high, low = low, high
+	}
+	top := low
+	if plus {
+		top = high - 1
+	}
+	for r := low; r <= top; r++ {
+		combos = append(combos, unpairedCombos(high, r, suitedness)...)
+	}
+	return combos, nil
+}
+
+// Returns the 6 combinations of a pair of the specified rank.
+func pairCombos(rank cards.Rank) [][2]cards.Card {
+	combos := [][2]cards.Card{}
+	for i := 0; i < len(allSuits); i++ {
+		for j := i + 1; j < len(allSuits); j++ {
+			combos = append(combos, [2]cards.Card{cards.NewCard(rank, allSuits[i]), cards.NewCard(rank, allSuits[j])})
+		}
+	}
+	return combos
+}
+
+// Returns the combinations of two different ranks. suitedness is "s" for only suited combinations,
+// "o" for only offsuit combinations, or empty for both.
+func unpairedCombos(high cards.Rank, low cards.Rank, suitedness string) [][2]cards.Card {
+	combos := [][2]cards.Card{}
+	for _, highSuit := range allSuits {
+		for _, lowSuit := range allSuits {
+			suited := highSuit == lowSuit
+			if (suitedness == "s" && !suited) || (suitedness == "o" && suited) {
+				continue
+			}
+			combos = append(combos, [2]cards.Card{cards.NewCard(high, highSuit), cards.NewCard(low, lowSuit)})
+		}
+	}
+	return combos
+}
+
+// AllStartingHands returns all 1326 distinct combinations of two hole cards, each appearing once
+// regardless of the order of its cards, for building preflop tables.
+func AllStartingHands() [][2]cards.Card {
+	hands := [][2]cards.Card{}
+	cards.ForEachCombination(cards.AllCards(), 2, func(combo []cards.Card) {
+		hands = append(hands, [2]cards.Card{combo[0], combo[1]})
+	})
+	return hands
+}
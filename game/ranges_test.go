@@ -0,0 +1,86 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		notation       string
+		expectedCombos int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"AA,KK,AKs", 16},
+		{"QQ+", 18},
+		{"ATs+", 16},
+		{"QJo+", 12},
+		{"KQ, KQs", 16},
+		{"22+", 78},
+	}
+	for _, test := range tests {
+		combos, err := ParseRange(test.notation)
+		if err != nil {
+			t.Errorf("Expected ParseRange(%q) not to return an error, but it returned %v.", test.notation, err)
+		}
+		if len(combos) != test.expectedCombos {
+			t.Errorf("Expected ParseRange(%q) to return %v combinations, but instead it returned %v.",
+				test.notation,
+				test.expectedCombos,
+				len(combos))
+		}
+	}
+}
+
+func TestParseRangeSuitedness(t *testing.T) {
+	suited, _ := ParseRange("AKs")
+	for _, combo := range suited {
+		if combo[0].Suit() != combo[1].Suit() {
+			t.Errorf("Expected every combination of AKs to be suited, but %v is not.", combo)
+		}
+	}
+	offsuit, _ := ParseRange("AKo")
+	for _, combo := range offsuit {
+		if combo[0].Suit() == combo[1].Suit() {
+			t.Errorf("Expected every combination of AKo to be offsuit, but %v is not.", combo)
+		}
+	}
+	pairs, _ := ParseRange("77+")
+	for _, combo := range pairs {
+		if combo[0].Rank() != combo[1].Rank() || combo[0].Rank() < cards.Seven {
+			t.Errorf("Expected every combination of 77+ to be a pair of sevens or better, but %v is not.", combo)
+		}
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	tests := []string{"", "A", "AX", "AKx", "AAs", "AKo,", "AKQJ"}
+	for _, test := range tests {
+		_, err := ParseRange(test)
+		if err == nil {
+			t.Errorf("Expected ParseRange(%q) to return an error, but it didn't.", test)
+		}
+	}
+}
+
+func TestAllStartingHands(t *testing.T) {
+	hands := AllStartingHands()
+	if len(hands) != 1326 {
+		t.Errorf("Expected 1326 starting hands, but instead there were %v.", len(hands))
+	}
+	seen := make(map[cards.CardSet]bool)
+	for _, h := range hands {
+		set := cards.NewCardSet(h[:])
+		if set.Count() != 2 {
+			t.Errorf("Expected each starting hand to be two different cards, but %v isn't.", h)
+		}
+		if seen[set] {
+			t.Errorf("Expected each starting hand to appear once, but %v appeared more than once.", h)
+		}
+		seen[set] = true
+	}
+}
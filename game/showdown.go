@@ -0,0 +1,246 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// ShowdownResult describes how the pot was awarded at showdown.
+type ShowdownResult struct {
+	Pots     []PotResult          // the main pot followed by any side pots
+	Winnings map[int]int          // total amount won by each player across every pot
+	Shown    map[int][]cards.Card // hole cards that players chose to show with ShowCard, by player id
+	BadBeats []BadBeat            // hands at least as strong as the rules' bad beat threshold that lost
+	Rake     int                  // amount taken from the pot as rake before it was awarded
+}
+
+// BadBeat is a very strong hand losing at showdown, which can qualify for a bad beat jackpot.
+type BadBeat struct {
+	Loser       int
+	LosingHand  cards.Hand // the best five cards of the losing player
+	Winner      int
+	WinningHand cards.Hand // the best five cards of the winning player
+}
+
+// BadBeatObserver is an Observer that is also told about every bad beat at showdown.
+type BadBeatObserver interface {
+	Observer
+	BadBeat(beat BadBeat)
+}
+
+// RankedHand is a player's best hand at showdown.
+type RankedHand struct {
+	PlayerID int
+	Result   cards.Result
+}
+
+// PotResult describes how a main or side pot was awarded at showdown.
+type PotResult struct {
+	Amount      int
+	Eligible    []int // ids of the players who could win the pot
+	HighWinners []int // ids of the eligible players with the best high hand
+	LowWinners  []int // ids of the eligible players with the best qualifying low hand, empty if no low hand qualified
+}
+
+// DistributePot compares the hands of the players participating in the round and awards the pot to
+// the winners according to the game's showdown mode. When players are all-in for different amounts
+// the pot is split into a main pot and side pots, and each is awarded independently to the best hand
+// among the players eligible for it. Split pots are divided evenly, with any odd chips going to the
+// winners closest to the left of the button. In HiLo mode the odd chip from splitting a pot in half goes to the high hand.
+// Any bets from the current betting round are collected into the pot first. In a cash game the rake is
+// taken before the pot is awarded, from the main pot first and then from each side pot in turn, leaving
+// out any pot that only one player is eligible to win.
+func (g *GameState) DistributePot() (ShowdownResult, error) {
+	if len(g.participating) == 0 {
+		return ShowdownResult{}, errors.New("error distributing pot: no players are participating in the round")
+	}
+	g.collectBets()
+	result := ShowdownResult{Pots: []PotResult{}, Winnings: make(map[int]int), Shown: make(map[int][]cards.Card), BadBeats: g.badBeats()}
+	for _, p := range g.table {
+		if shown, _ := g.ShownCards(p.id); len(shown) > 0 {
+			result.Shown[p.id] = shown
+		}
+	}
+	pots := g.pot.SidePots(g.participating)
+	result.Rake = g.takeRake(pots)
+	for _, pot := range pots {
+		potResult := PotResult{
+			Amount:      pot.Amount,
+			Eligible:    pot.Eligible,
+			HighWinners: g.highWinners(pot.Eligible),
+			LowWinners:  []int{},
+		}
+		if g.rules.ShowdownMode == HiLo {
+			potResult.LowWinners = g.lowWinners(pot.Eligible)
+		}
+		if len(potResult.LowWinners) > 0 {
+			lowHalf := pot.Amount / 2
+			g.splitPot(pot.Amount-lowHalf, potResult.HighWinners, result.Winnings)
+			g.splitPot(lowHalf, potResult.LowWinners, result.Winnings)
+		} else {
+			g.splitPot(pot.Amount, potResult.HighWinners, result.Winnings)
+		}
+		result.Pots = append(result.Pots, potResult)
+	}
+	g.history.winnings, g.history.rake = make(map[int]int), result.Rake
+	for id, amount := range result.Winnings {
+		g.table[id].money += amount
+		g.history.winnings[id] = amount
+	}
+	if g.phase < Showdown {
+		g.potByStreet[g.phase] = g.pot.Total()
+	}
+	g.pot = NewPot()
+	g.phase = Showdown
+	g.notifyObservers()
+	for _, obs := range g.observers {
+		if badBeatObserver, ok := obs.o.(BadBeatObserver); ok {
+			for _, beat := range result.BadBeats {
+				badBeatObserver.BadBeat(beat)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ShowdownRanking returns the best hand of every player participating in the round, ordered from the
+// best hand to the worst. Players with tied hands are ordered by id. It returns an error if the flop
+// hasn't been dealt, since there aren't enough cards to make a hand.
+func (g GameState) ShowdownRanking() ([]RankedHand, error) {
+	ranking := []RankedHand{}
+	for _, id := range g.participating {
+		result, err := cards.EvaluateBestOfSeven(g.playerCards(id))
+		if err != nil {
+			return nil, fmt.Errorf("error ranking player %v's hand: %v", id, err)
+		}
+		ranking = append(ranking, RankedHand{id, result})
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Result.Score != ranking[j].Result.Score {
+			return ranking[i].Result.Score > ranking[j].Result.Score
+		}
+		return ranking[i].PlayerID < ranking[j].PlayerID
+	})
+	return ranking, nil
+}
+
+// Removes the rake from the pots, starting with the main pot, and returns the amount taken. Only pots
+// contested by more than one player are raked, so a pot won when everyone else folds and an uncalled bet
+// returned to the player who made it aren't. No rake is taken in a tournament.
+func (g GameState) takeRake(pots []SidePot) int {
+	if g.rules.Mode == TournamentGame {
+		return 0
+	}
+	total := 0
+	for _, pot := range pots {
+		if len(pot.Eligible) > 1 {
+			total += pot.Amount
+		}
+	}
+	rake := int(float64(total) * g.rules.RakeRate)
+	if g.rules.RakeCap > 0 && rake > g.rules.RakeCap {
+		rake = g.rules.RakeCap
+	}
+	remaining := rake
+	for i := range pots {
+		if len(pots[i].Eligible) < 2 {
+			continue
+		}
+		taken := minInt(remaining, pots[i].Amount)
+		pots[i].Amount -= taken
+		remaining -= taken
+	}
+	return rake
+}
+
+// Returns every losing hand at showdown that is at least as strong as the rules' bad beat threshold,
+// along with the hand that beat it. There are no bad beats if the rules don't set a threshold.
+func (g GameState) badBeats() []BadBeat {
+	beats := []BadBeat{}
+	if len(g.rules.BadBeatThreshold) == 0 || len(g.participating) < 2 {
+		return beats
+	}
+	winners := g.highWinners(g.participating)
+	winningHand := cards.BestHand(g.playerCards(winners[0]))
+	for _, id := range g.participating {
+		if intInSlice(id, winners) {
+			continue
+		}
+		if cards.CompareHands(g.playerCards(id), g.rules.BadBeatThreshold) >= 0 {
+			beats = append(beats, BadBeat{id, cards.BestHand(g.playerCards(id)), winners[0], winningHand})
+		}
+	}
+	return beats
+}
+
+// Returns the hole cards of the specified player combined with the community cards.
+func (g GameState) playerCards(playerID int) []cards.Card {
+	hole := g.table[playerID].hand
+	return append([]cards.Card{hole[0], hole[1]}, g.community...)
+}
+
+// Returns the ids of the contenders with the best high hand.
+func (g GameState) highWinners(contenders []int) []int {
+	winners := []int{}
+	for _, id := range contenders {
+		if len(winners) == 0 {
+			winners = append(winners, id)
+			continue
+		}
+		switch cards.CompareHands(g.playerCards(id), g.playerCards(winners[0])) {
+		case 1:
+			winners = []int{id}
+		case 0:
+			winners = append(winners, id)
+		}
+	}
+	return winners
+}
+
+// Returns the ids of the contenders with the best eight-or-better low hand, or an empty slice if
+// none of them have a qualifying low hand.
+func (g GameState) lowWinners(contenders []int) []int {
+	winners := []int{}
+	for _, id := range contenders {
+		if !cards.QualifiesForLow(g.playerCards(id)) {
+			continue
+		}
+		if len(winners) == 0 {
+			winners = append(winners, id)
+			continue
+		}
+		switch cards.CompareLowHands(g.playerCards(id), g.playerCards(winners[0])) {
+		case 1:
+			winners = []int{id}
+		case 0:
+			winners = append(winners, id)
+		}
+	}
+	return winners
+}
+
+// Divides the amount evenly between the winners and adds it to their winnings. Any odd chips are given
+// one at a time to the winners in seat order, starting with the first seat clockwise from the button.
+func (g GameState) splitPot(amount int, winners []int, winnings map[int]int) {
+	sorted := append([]int{}, winners...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return g.seatsFromButton(sorted[i]) < g.seatsFromButton(sorted[j])
+	})
+	share := amount / len(sorted)
+	oddChips := amount % len(sorted)
+	for i, id := range sorted {
+		winnings[id] += share
+		if i < oddChips {
+			winnings[id]++
+		}
+	}
+}
+
+// Returns how many seats clockwise from the button the player is, from 1 for the seat to the left of the
+// button to the number of seats for the button itself.
+func (g GameState) seatsFromButton(playerID int) int {
+	return (playerID-g.buttonPos+len(g.table)-1)%len(g.table) + 1
+}
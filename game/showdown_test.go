@@ -0,0 +1,434 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// Returns a game in HiLo mode where players 0 and 1 hold the specified cards and are the only players
+// participating in the round. Players 0 and 1 each put half of the pot in, and player 2 folded after
+// putting in any odd chip.
+func hiLoGame(hand0 [2]cards.Card, hand1 [2]cards.Card, community []cards.Card, pot int) GameState {
+	g, _ := NewGameWithRules(3, 100, 4, Rules{ShowdownMode: HiLo}, nil)
+	g.participating = []int{0, 1}
+	g.table[0].hand = hand0
+	g.table[1].hand = hand1
+	g.community = community
+	commit(&g, map[int]int{0: pot / 2, 1: pot / 2, 2: pot % 2})
+	return g
+}
+
+// Puts the specified amount from each player into the pot.
+func commit(g *GameState, amounts map[int]int) {
+	for id, amount := range amounts {
+		g.table[id].money -= amount
+		g.pot.Add(id, amount)
+	}
+}
+
+func TestDistributePotHiLo(t *testing.T) {
+	lowBoard := []cards.Card{
+		cards.NewCard(cards.Ace, cards.Heart),
+		cards.NewCard(cards.Two, cards.Club),
+		cards.NewCard(cards.Three, cards.Diamond),
+		cards.NewCard(cards.King, cards.Heart),
+		cards.NewCard(cards.King, cards.Spade),
+	}
+	highBoard := []cards.Card{
+		cards.NewCard(cards.King, cards.Heart),
+		cards.NewCard(cards.Queen, cards.Spade),
+		cards.NewCard(cards.Nine, cards.Diamond),
+		cards.NewCard(cards.Two, cards.Club),
+		cards.NewCard(cards.Three, cards.Diamond),
+	}
+	wheelDraw := [2]cards.Card{cards.NewCard(cards.Four, cards.Spade), cards.NewCard(cards.Five, cards.Spade)}
+	tests := []struct {
+		name             string
+		hand0            [2]cards.Card
+		hand1            [2]cards.Card
+		community        []cards.Card
+		pot              int
+		expectedWinnings map[int]int
+	}{
+		{
+			// Player 0 has four kings, player 1 has the wheel for low.
+			"split",
+			[2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)},
+			wheelDraw,
+			lowBoard,
+			101,
+			map[int]int{0: 51, 1: 50},
+		},
+		{
+			// Player 1's wheel is both the best high hand and the best low hand.
+			"scoop",
+			[2]cards.Card{cards.NewCard(cards.Nine, cards.Club), cards.NewCard(cards.Nine, cards.Diamond)},
+			wheelDraw,
+			lowBoard,
+			100,
+			map[int]int{1: 100},
+		},
+		{
+			"no qualifying low",
+			[2]cards.Card{cards.NewCard(cards.Ace, cards.Spade), cards.NewCard(cards.Ace, cards.Diamond)},
+			[2]cards.Card{cards.NewCard(cards.Four, cards.Spade), cards.NewCard(cards.Jack, cards.Club)},
+			highBoard,
+			100,
+			map[int]int{0: 100},
+		},
+	}
+	for _, test := range tests {
+		g := hiLoGame(test.hand0, test.hand1, test.community, test.pot)
+		result, err := g.DistributePot()
+		if err != nil {
+			t.Errorf("%v: Expected DistributePot not to return an error, but it returned %v.", test.name, err)
+		}
+		for _, id := range []int{0, 1} {
+			if result.Winnings[id] != test.expectedWinnings[id] {
+				t.Errorf("%v: Expected player %v to win %v, but instead they won %v.",
+					test.name,
+					id,
+					test.expectedWinnings[id],
+					result.Winnings[id])
+			}
+			expectedMoney := 100 - test.pot/2 + test.expectedWinnings[id]
+			if g.table[id].money != expectedMoney {
+				t.Errorf("%v: Expected player %v to have %v, but instead they had %v.",
+					test.name,
+					id,
+					expectedMoney,
+					g.table[id].money)
+			}
+		}
+		if g.pot.Total() != 0 {
+			t.Errorf("%v: Expected the pot to be empty after it was distributed, but it was %v.", test.name, g.pot.Total())
+		}
+	}
+}
+
+func TestDistributePotHighOnly(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.participating = []int{0, 1}
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.Four, cards.Spade), cards.NewCard(cards.Five, cards.Spade)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Ace, cards.Heart),
+		cards.NewCard(cards.Two, cards.Club),
+		cards.NewCard(cards.Three, cards.Diamond),
+		cards.NewCard(cards.King, cards.Heart),
+		cards.NewCard(cards.King, cards.Spade),
+	}
+	commit(&g, map[int]int{0: 50, 1: 50})
+	result, _ := g.DistributePot()
+	if result.Winnings[0] != 100 || len(result.Pots[0].LowWinners) != 0 {
+		t.Errorf("Expected player 0 to win the whole pot with no low winners, but instead the result was %v.", result)
+	}
+}
+
+// Tests that a short stack who is all-in can only win the main pot, while the side pot between the
+// deeper stacks goes to the best hand among them.
+func TestDistributePotSidePots(t *testing.T) {
+	g, _ := NewGame(4, 100, 4)
+	g.participating = []int{0, 1, 2}
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.Ace, cards.Club), cards.NewCard(cards.Ace, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)}
+	g.table[2].hand = [2]cards.Card{cards.NewCard(cards.Queen, cards.Club), cards.NewCard(cards.Queen, cards.Diamond)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Two, cards.Heart),
+		cards.NewCard(cards.Seven, cards.Spade),
+		cards.NewCard(cards.Nine, cards.Diamond),
+		cards.NewCard(cards.Jack, cards.Club),
+		cards.NewCard(cards.Four, cards.Heart),
+	}
+	// Player 0 is all-in for 20 and player 3 folded after putting in 10.
+	commit(&g, map[int]int{0: 20, 1: 50, 2: 50, 3: 10})
+
+	result, err := g.DistributePot()
+	if err != nil {
+		t.Fatalf("Expected DistributePot not to return an error, but it returned %v.", err)
+	}
+	if len(result.Pots) != 2 {
+		t.Fatalf("Expected a main pot and a side pot, but instead there were %v pots.", len(result.Pots))
+	}
+	mainPot, sidePot := result.Pots[0], result.Pots[1]
+	if mainPot.Amount != 70 || !intSlicesEqual(mainPot.Eligible, []int{0, 1, 2}) || !intSlicesEqual(mainPot.HighWinners, []int{0}) {
+		t.Errorf("Expected player 0 to win a main pot of 70 that players 0, 1, and 2 were eligible for, but instead the main pot was %+v.", mainPot)
+	}
+	if sidePot.Amount != 60 || !intSlicesEqual(sidePot.Eligible, []int{1, 2}) || !intSlicesEqual(sidePot.HighWinners, []int{1}) {
+		t.Errorf("Expected player 1 to win a side pot of 60 that players 1 and 2 were eligible for, but instead the side pot was %+v.", sidePot)
+	}
+	expectedMoney := map[int]int{0: 150, 1: 110, 2: 50, 3: 90}
+	for id, expected := range expectedMoney {
+		if g.table[id].money != expected {
+			t.Errorf("Expected player %v to have %v after the showdown, but instead they had %v.", id, expected, g.table[id].money)
+		}
+	}
+}
+
+// Tests that a side pot is split when the deeper stacks tie, while the short stack takes the main pot.
+func TestDistributePotSplitSidePot(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.participating = []int{0, 1, 2}
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.Ace, cards.Club), cards.NewCard(cards.Ace, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.Three, cards.Diamond)}
+	g.table[2].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Spade), cards.NewCard(cards.Three, cards.Club)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Two, cards.Heart),
+		cards.NewCard(cards.Seven, cards.Spade),
+		cards.NewCard(cards.Nine, cards.Diamond),
+		cards.NewCard(cards.Jack, cards.Club),
+		cards.NewCard(cards.Four, cards.Heart),
+	}
+	commit(&g, map[int]int{0: 10, 1: 41, 2: 40})
+
+	result, _ := g.DistributePot()
+	expectedWinnings := map[int]int{0: 30, 1: 31, 2: 30}
+	for id, expected := range expectedWinnings {
+		if result.Winnings[id] != expected {
+			t.Errorf("Expected player %v to win %v, but instead they won %v.", id, expected, result.Winnings[id])
+		}
+	}
+}
+
+// Tests that the odd chip of a three-way split goes to the winner closest to the left of the button.
+func TestDistributePotOddChip(t *testing.T) {
+	tests := []struct {
+		button        int
+		oddChipToSeat int
+	}{
+		{1, 2},
+		{2, 3},
+		{3, 0},
+		{0, 2},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(4, 100, 4)
+		g.buttonPos = test.button
+		// every player plays the royal flush on the board
+		g.participating = []int{0, 2, 3}
+		g.community = parseCards("Ah Kh Qh Jh Th")
+		commit(&g, map[int]int{0: 33, 1: 1, 2: 33, 3: 33})
+
+		result, _ := g.DistributePot()
+		for _, id := range g.participating {
+			expected := 33
+			if id == test.oddChipToSeat {
+				expected = 34
+			}
+			if result.Winnings[id] != expected {
+				t.Errorf("Expected player %v to win %v with the button in seat %v, but instead they won %v.",
+					id, expected, test.button, result.Winnings[id])
+			}
+		}
+	}
+}
+
+func TestShowCard(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.participating = []int{0, 1}
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.Four, cards.Spade), cards.NewCard(cards.Five, cards.Spade)}
+	g.table[2].hand = [2]cards.Card{cards.NewCard(cards.Seven, cards.Heart), cards.NewCard(cards.Two, cards.Diamond)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Ace, cards.Heart),
+		cards.NewCard(cards.Two, cards.Club),
+		cards.NewCard(cards.Three, cards.Diamond),
+		cards.NewCard(cards.King, cards.Heart),
+		cards.NewCard(cards.King, cards.Spade),
+	}
+	commit(&g, map[int]int{0: 50, 1: 50})
+	// Player 2 folded and shows only their seven.
+	if err := g.ShowCard(2, 0); err != nil {
+		t.Fatalf("Expected ShowCard to succeed, but instead got error: %v", err)
+	}
+	result, _ := g.DistributePot()
+	shown := result.Shown[2]
+	if len(shown) != 1 || shown[0] != g.table[2].hand[0] {
+		t.Errorf("Expected player 2 to have shown only %v, but instead they showed %v.", g.table[2].hand[0], shown)
+	}
+	if _, ok := result.Shown[0]; ok {
+		t.Errorf("Expected player 0 to have shown no cards, but instead they showed %v.", result.Shown[0])
+	}
+
+	for _, index := range []int{-1, 2} {
+		if err := g.ShowCard(2, index); err == nil {
+			t.Errorf("Expected ShowCard with card index %v to return an error, but it didn't.", index)
+		}
+	}
+	if err := g.ShowCard(5, 0); err == nil {
+		t.Errorf("Expected ShowCard for a player who doesn't exist to return an error, but it didn't.")
+	}
+}
+
+// Tests that a player who can't match a bet calls all-in for the rest of their stack and can only win
+// the amount they matched from each other player.
+func TestShortAllInCall(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.Call(2)
+	g.whoseTurn = 0
+	g.Call(0)
+	g.AdvancePhase()
+	g.table[1].money = 20
+
+	g.Bet(0, 50)
+	if err := g.Call(1); err != nil {
+		t.Fatalf("Expected player 1 to be able to call all-in for less, but instead got error: %v", err)
+	}
+	if committed, _ := g.CommittedThisHand(1); g.table[1].money != 0 || committed != 24 {
+		t.Errorf("Expected player 1 to call all-in for 20 and have 24 in the pot, but instead they have %v left and %v in the pot.",
+			g.table[1].money, committed)
+	}
+	g.whoseTurn = 2
+	g.Call(2)
+
+	g.table[0].hand = [2]cards.Card{cards.NewCard(cards.King, cards.Club), cards.NewCard(cards.King, cards.Diamond)}
+	g.table[1].hand = [2]cards.Card{cards.NewCard(cards.Ace, cards.Club), cards.NewCard(cards.Ace, cards.Diamond)}
+	g.table[2].hand = [2]cards.Card{cards.NewCard(cards.Queen, cards.Club), cards.NewCard(cards.Queen, cards.Diamond)}
+	g.community = []cards.Card{
+		cards.NewCard(cards.Two, cards.Heart),
+		cards.NewCard(cards.Seven, cards.Spade),
+		cards.NewCard(cards.Nine, cards.Diamond),
+		cards.NewCard(cards.Jack, cards.Club),
+		cards.NewCard(cards.Four, cards.Heart),
+	}
+	result, _ := g.DistributePot()
+	if len(result.Pots) != 2 || result.Pots[0].Amount != 72 || result.Pots[1].Amount != 60 {
+		t.Fatalf("Expected a main pot of 72 and a side pot of 60, but instead the pots were %+v.", result.Pots)
+	}
+	expectedMoney := map[int]int{0: 106, 1: 72, 2: 46}
+	for id, expected := range expectedMoney {
+		if g.table[id].money != expected {
+			t.Errorf("Expected player %v to have %v after the showdown, but instead they had %v.", id, expected, g.table[id].money)
+		}
+	}
+}
+
+// Records every bad beat it is told about.
+type badBeatRecorder struct {
+	recordingObserver
+	beats []BadBeat
+}
+
+func (r *badBeatRecorder) BadBeat(beat BadBeat) {
+	r.beats = append(r.beats, beat)
+}
+
+func TestBadBeat(t *testing.T) {
+	acesFull := cards.Hand(parseCards("Ac Ad Ah 2c 2d"))
+	tests := []struct {
+		name      string
+		hole0     string
+		hole1     string
+		board     string
+		threshold cards.Hand
+		expected  int
+	}{
+		{"quads lose to a straight flush", "9h 9c", "7s 8s", "9s Ts Js 9d 2c", acesFull, 1},
+		{"aces full loses to quads", "Ah As", "Kh Ks", "Ac Kc Kd 2h 2s", acesFull, 1},
+		{"kings full doesn't qualify", "Kh Ks", "Ah As", "Ac Kc Ad 2h 2s", acesFull, 0},
+		{"no threshold", "9h 9c", "7s 8s", "9s Ts Js 9d 2c", nil, 0},
+	}
+	for _, test := range tests {
+		g := allInGame(test.hole0, test.hole1, test.board)
+		g.rules.BadBeatThreshold = test.threshold
+		commit(&g, map[int]int{0: 50, 1: 50})
+		recorder := &badBeatRecorder{}
+		g.AddGodViewObserver(recorder)
+		result, _ := g.DistributePot()
+		if len(result.BadBeats) != test.expected || len(recorder.beats) != test.expected {
+			t.Errorf("%v: Expected %v bad beats, but instead the result had %v and the observer was told about %v.",
+				test.name, test.expected, len(result.BadBeats), len(recorder.beats))
+			continue
+		}
+		if test.expected == 0 {
+			continue
+		}
+		beat := recorder.beats[0]
+		if beat.Loser != 0 || beat.Winner != 1 || len(beat.LosingHand) != 5 || len(beat.WinningHand) != 5 {
+			t.Errorf("%v: Expected player 0's five card hand to lose to player 1's, but instead the bad beat was %+v.", test.name, beat)
+		}
+	}
+}
+
+func TestShowdownRanking(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.participating = []int{0, 1, 2}
+	for id, hole := range []string{"Ah Qh", "Kh Ks", "9h 8h"} {
+		copy(g.table[id].hand[:], parseCards(hole))
+	}
+	if _, err := g.ShowdownRanking(); err == nil {
+		t.Errorf("Expected an error ranking hands before the flop, but there wasn't one.")
+	}
+	g.community = parseCards("Kd 7c 2h 9s 3d")
+	ranking, err := g.ShowdownRanking()
+	if err != nil {
+		t.Fatalf("Expected ShowdownRanking not to return an error, but it returned %v.", err)
+	}
+	// Player 1 has three kings, player 2 a pair of nines, and player 0 ace high.
+	expected := []struct {
+		id       int
+		category cards.HandCategory
+	}{{1, cards.ThreeOfAKind}, {2, cards.Pair}, {0, cards.HighCard}}
+	if len(ranking) != len(expected) {
+		t.Fatalf("Expected %v ranked hands, but instead there were %v.", len(expected), len(ranking))
+	}
+	for i, e := range expected {
+		if ranking[i].PlayerID != e.id || ranking[i].Result.Category != e.category {
+			t.Errorf("Expected place %v to be player %v with %v, but instead it was player %v with %v.",
+				i+1, e.id, e.category, ranking[i].PlayerID, ranking[i].Result.Category)
+		}
+	}
+}
+
+func TestRake(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         GameMode
+		rate         float64
+		cap          int
+		expectedRake int
+	}{
+		{"cash game", CashGame, 0.05, 0, 10},
+		{"cash game with a cap", CashGame, 0.05, 3, 3},
+		{"cash game without rake", CashGame, 0, 0, 0},
+		{"tournament", TournamentGame, 0.05, 0, 0},
+	}
+	for _, test := range tests {
+		// Player 0's set of aces beats player 1's kings.
+		g := allInGame("Ah As", "Kh Ks", "Ac 7d 2c 9s 4h")
+		g.rules.Mode, g.rules.RakeRate, g.rules.RakeCap = test.mode, test.rate, test.cap
+		commit(&g, map[int]int{0: 100, 1: 100})
+		result, _ := g.DistributePot()
+		if result.Rake != test.expectedRake || result.Winnings[0] != 200-test.expectedRake {
+			t.Errorf("%v: Expected a rake of %v and player 0 to win %v, but instead the rake was %v and they won %v.",
+				test.name, test.expectedRake, 200-test.expectedRake, result.Rake, result.Winnings[0])
+		}
+	}
+}
+
+func TestRakeUncontestedPots(t *testing.T) {
+	// Player 1's aces win the main pot from player 0, who covers their all-in and gets back the $40
+	// nobody called.
+	g := allInGame("Kh Ks", "Ah As", "Ac 7d 2c 9s 4h")
+	g.rules.RakeRate = 0.05
+	commit(&g, map[int]int{0: 100, 1: 60})
+	result, _ := g.DistributePot()
+	if result.Rake != 6 || result.Winnings[1] != 114 || result.Winnings[0] != 40 {
+		t.Errorf("Expected a rake of 6 from the main pot only, with player 1 winning 114 and player 0 getting back 40, but instead the rake was %v and they won %v and %v.",
+			result.Rake, result.Winnings[1], result.Winnings[0])
+	}
+
+	// Player 1 folded, so player 0 wins the pot uncontested.
+	g = allInGame("Kh Ks", "Ah As", "Ac 7d 2c 9s 4h")
+	g.rules.RakeRate = 0.05
+	commit(&g, map[int]int{0: 40, 1: 20})
+	g.participating = []int{0}
+	result, _ = g.DistributePot()
+	if result.Rake != 0 || result.Winnings[0] != 60 {
+		t.Errorf("Expected no rake from an uncontested pot and player 0 to win 60, but instead the rake was %v and they won %v.",
+			result.Rake, result.Winnings[0])
+	}
+}
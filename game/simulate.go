@@ -0,0 +1,111 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PlayerAgent decides the action a player takes on their turn, given a copy of the game state.
+type PlayerAgent func(g GameState, playerID int) Action
+
+// RunToShowdown plays out the rest of the current hand, asking each player's agent for their action on
+// their turn, advancing through the phases as each betting round ends, and distributing the pot. Players
+// who are all-in are skipped, and the remaining community cards are dealt once no more betting is possible.
+// It returns an error if a player whose turn it is has no agent, or their agent chooses an invalid action.
+func (g *GameState) RunToShowdown(agents map[int]PlayerAgent) (ShowdownResult, error) {
+	if g.phase == Showdown {
+		return ShowdownResult{}, errors.New("error running to showdown: the hand is already over")
+	}
+	for {
+		if err := g.StepUntilActionRequired(); err != nil {
+			return ShowdownResult{}, fmt.Errorf("error running to showdown: %v", err)
+		}
+		if g.handComplete() {
+			break
+		}
+		playerID := g.whoseTurn
+		agent, ok := agents[playerID]
+		if !ok {
+			return ShowdownResult{}, fmt.Errorf("error running to showdown: there is no agent for player %v", playerID)
+		}
+		if err := g.Apply(playerID, agent(g.Clone(), playerID)); err != nil {
+			return ShowdownResult{}, fmt.Errorf("error running to showdown: %v", err)
+		}
+	}
+	return g.DistributePot()
+}
+
+// StepUntilActionRequired moves the hand forward until a player has to decide on an action or no more
+// actions are possible. It advances to the next street when a betting round is complete, skips players
+// who are all-in, and deals the rest of the board once no more betting is possible. It stops without
+// distributing the pot when the hand is complete, which is when one player is left or the betting on
+// the river is complete, so the caller can call DistributePot. Calling it again before anything else
+// changes does nothing.
+func (g *GameState) StepUntilActionRequired() error {
+	for !g.handComplete() {
+		if g.bettingRoundComplete() {
+			if err := g.AdvancePhase(); err != nil {
+				return fmt.Errorf("error stepping the hand: %v", err)
+			}
+			continue
+		}
+		if g.CanAct(g.whoseTurn) {
+			return nil
+		}
+		g.whoseTurn = g.getNextPlayersTurn()
+	}
+	return nil
+}
+
+// Returns true if no more actions can be taken in the hand, because at most one player is left, the
+// betting on the river is complete, or the pot has been distributed.
+func (g GameState) handComplete() bool {
+	return len(g.participating) <= 1 || g.phase == Showdown || (g.phase == River && g.bettingRoundComplete())
+}
+
+// CheckDown returns true if every participating player but one is all-in and the player with chips left
+// has matched the highest bet. With nobody left to bet against, the rest of the board is dealt without
+// any more betting. Unlike when every player is all-in, the player with chips can still be asked to act
+// if they face a bet they haven't called.
+func (g GameState) CheckDown() bool {
+	if len(g.participating) < 2 {
+		return false
+	}
+	chipped := -1
+	for _, id := range g.participating {
+		if !g.CanAct(id) {
+			continue
+		}
+		if chipped != -1 {
+			return false
+		}
+		chipped = id
+	}
+	return chipped != -1 && g.table[chipped].amountBetInRound >= g.highestBetInRound
+}
+
+// Returns true if every participating player who isn't all-in has acted and matched the highest bet
+// of the round, if every player is all-in, or if the hand is checked down. Posting a blind doesn't count
+// as acting, so preflop the round isn't over when everyone limps until the big blind, who acts last,
+// takes their option to check or raise.
+func (g GameState) bettingRoundComplete() bool {
+	canAct := 0
+	for _, id := range g.participating {
+		if !g.CanAct(id) {
+			continue
+		}
+		if g.table[id].amountBetInRound < g.highestBetInRound {
+			return false
+		}
+		canAct++
+	}
+	if canAct == 0 || g.CheckDown() {
+		return true
+	}
+	for _, id := range g.participating {
+		if g.CanAct(id) && !g.table[id].actedInRound {
+			return false
+		}
+	}
+	return true
+}
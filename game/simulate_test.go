@@ -0,0 +1,227 @@
+package game
+
+import "testing"
+
+// Calls any bet and otherwise checks.
+func passiveAgent(g GameState, playerID int) Action {
+	if g.callAmount(playerID) > 0 {
+		return Action{Type: CallAction}
+	}
+	return Action{Type: CheckAction}
+}
+
+// Folds whenever there is a bet to call.
+func foldingAgent(g GameState, playerID int) Action {
+	if g.callAmount(playerID) > 0 {
+		return Action{Type: FoldAction}
+	}
+	return Action{Type: CheckAction}
+}
+
+// Goes all-in, calling if the bet is already more than the player's stack.
+func allInAgent(g GameState, playerID int) Action {
+	_, max := g.RaiseRange(playerID)
+	if max == 0 {
+		return Action{Type: CallAction}
+	}
+	return Action{Type: RaiseAction, Amount: max}
+}
+
+// Returns the total money held by the players at the table.
+func totalMoney(g GameState) int {
+	total := 0
+	for _, p := range g.table {
+		total += p.money
+	}
+	return total
+}
+
+func TestRunToShowdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		agents        map[int]PlayerAgent
+		community     int
+		potsWon       int
+		lastStanding  int
+		expectedTotal int
+	}{
+		{"everyone checks down", map[int]PlayerAgent{0: passiveAgent, 1: passiveAgent, 2: passiveAgent}, 5, 12, -1, 300},
+		{"everyone folds to the big blind", map[int]PlayerAgent{0: foldingAgent, 1: passiveAgent, 2: foldingAgent}, 0, 6, 1, 300},
+		{"all-in and called", map[int]PlayerAgent{0: passiveAgent, 1: passiveAgent, 2: allInAgent}, 5, 300, -1, 300},
+	}
+	for _, test := range tests {
+		g, _ := NewGame(3, 100, 4)
+		g.newRound()
+		result, err := g.RunToShowdown(test.agents)
+		if err != nil {
+			t.Fatalf("%v: Expected RunToShowdown not to return an error, but it returned %v.", test.name, err)
+		}
+		if g.phase != Showdown || len(g.community) != test.community {
+			t.Errorf("%v: Expected the hand to end at showdown with %v community cards, but instead it ended in phase %v with %v.",
+				test.name, test.community, g.phase, len(g.community))
+		}
+		won := 0
+		for _, amount := range result.Winnings {
+			won += amount
+		}
+		if won != test.potsWon {
+			t.Errorf("%v: Expected %v to be won, but instead %v was won.", test.name, test.potsWon, won)
+		}
+		if test.lastStanding != -1 && result.Winnings[test.lastStanding] != test.potsWon {
+			t.Errorf("%v: Expected player %v to win the pot, but instead the winnings were %v.", test.name, test.lastStanding, result.Winnings)
+		}
+		if total := totalMoney(g); total != test.expectedTotal {
+			t.Errorf("%v: Expected the players to have %v between them after the hand, but instead they had %v.", test.name, test.expectedTotal, total)
+		}
+	}
+}
+
+func TestBigBlindOption(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Player 0 is the small blind and player 1 is the big blind, so player 2 acts first.
+	g.Call(2)
+	g.Call(0)
+	if g.bettingRoundComplete() || g.whoseTurn != 1 {
+		t.Fatalf("Expected the big blind to have the option after everyone limps, but instead the round was complete: %v and it was player %v's turn.",
+			g.bettingRoundComplete(), g.whoseTurn)
+	}
+	if err := g.Raise(1, 8); err != nil {
+		t.Fatalf("Expected the big blind to be able to raise on their option, but instead got error %v.", err)
+	}
+	if g.bettingRoundComplete() || g.whoseTurn != 2 {
+		t.Errorf("Expected the action to return to player 2 after the big blind raises, but instead the round was complete: %v and it was player %v's turn.",
+			g.bettingRoundComplete(), g.whoseTurn)
+	}
+	g.Call(2)
+	g.Call(0)
+	if !g.bettingRoundComplete() {
+		t.Errorf("Expected the round to be complete once the limpers call the big blind's raise, but it wasn't.")
+	}
+}
+
+func TestRunToShowdownErrors(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	if _, err := g.RunToShowdown(map[int]PlayerAgent{0: passiveAgent, 1: passiveAgent}); err == nil {
+		t.Errorf("Expected an error running to showdown without an agent for player 2, but there wasn't one.")
+	}
+
+	g.newRound()
+	alwaysBetting := func(g GameState, playerID int) Action {
+		return Action{Type: BetAction, Amount: 10}
+	}
+	agents := map[int]PlayerAgent{0: alwaysBetting, 1: alwaysBetting, 2: alwaysBetting}
+	if _, err := g.RunToShowdown(agents); err == nil {
+		t.Errorf("Expected an error when an agent chooses an invalid action, but there wasn't one.")
+	}
+
+	g.phase = Showdown
+	if _, err := g.RunToShowdown(agents); err == nil {
+		t.Errorf("Expected an error running a finished hand to showdown, but there wasn't one.")
+	}
+}
+
+func TestStepUntilActionRequired(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	// Player 2 acts first preflop, so there is nothing to step through.
+	if err := g.StepUntilActionRequired(); err != nil || g.phase != PreFlop || g.whoseTurn != 2 {
+		t.Errorf("Expected to stop for player 2 preflop, but instead stopped for player %v on the %v with error %v.", g.whoseTurn, g.phase, err)
+	}
+	g.Call(2)
+	g.Call(0)
+	g.Check(1)
+	// The preflop betting is complete, so the flop is dealt and player 0 acts first.
+	if err := g.StepUntilActionRequired(); err != nil || g.phase != Flop || g.whoseTurn != 0 {
+		t.Errorf("Expected to stop for player 0 on the flop, but instead stopped for player %v on the %v with error %v.", g.whoseTurn, g.phase, err)
+	}
+	// Stepping again before anyone acts changes nothing.
+	community := len(g.community)
+	if err := g.StepUntilActionRequired(); err != nil || g.phase != Flop || g.whoseTurn != 0 || len(g.community) != community {
+		t.Errorf("Expected stepping twice to do nothing, but instead stopped for player %v on the %v with error %v.", g.whoseTurn, g.phase, err)
+	}
+	// Player 1 is all-in, and once players 0 and 2 are too, the rest of the board is dealt.
+	g.table[1].money = 0
+	g.Bet(0, 96)
+	g.whoseTurn = 2
+	g.Call(2)
+	if err := g.StepUntilActionRequired(); err != nil || g.phase != River || len(g.community) != 5 || !g.handComplete() {
+		t.Errorf("Expected the board to be run out to the river, but instead the hand stopped on the %v with %v community cards and error %v.",
+			g.phase, len(g.community), err)
+	}
+}
+
+func TestStepUntilActionRequiredEveryoneFolds(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.Fold(2)
+	g.Fold(0)
+	if err := g.StepUntilActionRequired(); err != nil || g.phase != PreFlop || !g.handComplete() {
+		t.Errorf("Expected the hand to be complete preflop once everyone folds to the big blind, but instead it was on the %v with error %v.", g.phase, err)
+	}
+}
+
+func TestCheckDown(t *testing.T) {
+	g, _ := NewGame(3, 100, 4)
+	g.table[1].money = 300
+	g.newRound()
+	// Players 2 and 0 go all-in, leaving the big blind as the only player with chips.
+	g.Apply(2, allInAgent(g, 2))
+	g.Apply(0, allInAgent(g, 0))
+	if g.CheckDown() {
+		t.Errorf("Expected the hand not to be checked down while the big blind hasn't called, but it was.")
+	}
+	g.Apply(1, Action{Type: CallAction})
+	if !g.CheckDown() {
+		t.Errorf("Expected the hand to be checked down once the big blind called, but it wasn't.")
+	}
+
+	prompted := 0
+	promptedAgent := func(g GameState, playerID int) Action {
+		prompted++
+		return Action{Type: CheckAction}
+	}
+	result, err := g.RunToShowdown(map[int]PlayerAgent{1: promptedAgent})
+	if err != nil {
+		t.Fatalf("Expected RunToShowdown not to return an error, but it returned %v.", err)
+	}
+	if prompted != 0 {
+		t.Errorf("Expected the player with chips not to be asked to act, but they were asked %v times.", prompted)
+	}
+	if len(g.community) != 5 || len(result.Pots) == 0 {
+		t.Errorf("Expected the board to be dealt and the pot awarded, but there were %v community cards and %v pots.", len(g.community), len(result.Pots))
+	}
+}
+
+func TestActionsAfterHandOver(t *testing.T) {
+	actions := []Action{{Type: FoldAction}, {Type: CheckAction}, {Type: CallAction}, {Type: BetAction, Amount: 10}, {Type: RaiseAction, Amount: 10}}
+
+	// the pot has been distributed after a showdown
+	g, _ := NewGame(3, 100, 4)
+	g.newRound()
+	g.RunToShowdown(map[int]PlayerAgent{0: passiveAgent, 1: passiveAgent, 2: passiveAgent})
+	total := totalMoney(g)
+	for _, a := range actions {
+		if err := g.Apply(g.whoseTurn, a); err == nil {
+			t.Errorf("Expected a %v after the showdown to return an error, but it didn't.", a.Type)
+		}
+	}
+	if totalMoney(g) != total || g.potSize() != 0 {
+		t.Errorf("Expected actions after the showdown not to move any money, but the pot is %v.", g.potSize())
+	}
+
+	// everyone but the big blind folded
+	g, _ = NewGame(3, 100, 4)
+	g.newRound()
+	g.Fold(2)
+	g.Fold(0)
+	for _, a := range actions {
+		if err := g.Apply(1, a); err == nil {
+			t.Errorf("Expected a %v by the last player left to return an error, but it didn't.", a.Type)
+		}
+	}
+	if err := g.AnnounceRaise(1, 12); err == nil || g.announcedTotal != 0 {
+		t.Errorf("Expected announcing a raise by the last player left to return an error, but it announced %v.", g.announcedTotal)
+	}
+}
@@ -0,0 +1,138 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// Streets of Seven-Card Stud, named after the number of cards each player has once they are dealt.
+const (
+	ThirdStreet   = 3
+	SeventhStreet = 7
+)
+
+// MaxStudPlayers is the most players that can be dealt all seven cards of Seven-Card Stud from one deck.
+const MaxStudPlayers = 52 / SeventhStreet
+
+// StudHand is a player's cards in Seven-Card Stud.
+type StudHand struct {
+	Down []cards.Card // cards only the player can see
+	Up   []cards.Card // cards every player can see, in the order they were dealt
+}
+
+// Cards returns all of the cards in the hand.
+func (h StudHand) Cards() []cards.Card {
+	return append(append([]cards.Card{}, h.Down...), h.Up...)
+}
+
+// StudDeal deals the cards for a hand of Seven-Card Stud, where there are no community cards. Each player
+// is dealt two cards down and one up on third street, one card up on each of fourth, fifth, and sixth
+// street, and a final card down on seventh street, with a betting round after each.
+type StudDeal struct {
+	deck   cards.Deck
+	hands  []StudHand // hand of each player, by player id
+	street int        // the most recently dealt street, 0 before any cards are dealt
+}
+
+// NewStudDeal shuffles a deck for a hand of Seven-Card Stud between the specified number of players. As
+// in a game, the deck is shuffled from a seed drawn from src, so sources in the same state deal the same
+// cards, or from a source seeded with the current time if src is nil. It returns an error if there are
+// fewer than two players or more than MaxStudPlayers.
+func NewStudDeal(numPlayers int, src *cards.Source) (StudDeal, error) {
+	if numPlayers < 2 || numPlayers > MaxStudPlayers {
+		return StudDeal{}, fmt.Errorf("error creating stud deal: must have between 2 and %v players, not %v", MaxStudPlayers, numPlayers)
+	}
+	hands := make([]StudHand, numPlayers)
+	for i := range hands {
+		hands[i] = StudHand{[]cards.Card{}, []cards.Card{}}
+	}
+	if src == nil {
+		src = cards.NewTimeSource()
+	}
+	deck, _ := cards.GenerateDeckCommitted(src.Int63())
+	return StudDeal{deck, hands, 0}, nil
+}
+
+// DealStreet deals the cards for the next street to every player. It returns an error once seventh
+// street has been dealt.
+func (s *StudDeal) DealStreet() error {
+	switch {
+	case s.street == 0:
+		s.dealToAll(false)
+		s.dealToAll(false)
+		s.dealToAll(true)
+		s.street = ThirdStreet
+	case s.street < SeventhStreet-1:
+		s.dealToAll(true)
+		s.street++
+	case s.street == SeventhStreet-1:
+		s.dealToAll(false)
+		s.street++
+	default:
+		return fmt.Errorf("error dealing street: every street has been dealt")
+	}
+	return nil
+}
+
+// Street returns the most recently dealt street, which is 0 before any cards are dealt.
+func (s StudDeal) Street() int {
+	return s.street
+}
+
+// Hand returns the cards dealt to the specified player.
+func (s StudDeal) Hand(playerID int) (StudHand, error) {
+	if playerID < 0 || playerID >= len(s.hands) {
+		return StudHand{}, fmt.Errorf("there is no player with id %v", playerID)
+	}
+	hand := s.hands[playerID]
+	return StudHand{append([]cards.Card{}, hand.Down...), append([]cards.Card{}, hand.Up...)}, nil
+}
+
+// BringIn returns the id of the player who must make the forced bring-in bet on third street, which is
+// the player with the lowest up card. Ties are broken by suit, from clubs, the lowest, to spades.
+// It returns -1 before third street has been dealt.
+func (s StudDeal) BringIn() int {
+	if s.street < ThirdStreet {
+		return -1
+	}
+	bringIn := 0
+	for id := range s.hands {
+		card, lowest := s.hands[id].Up[0], s.hands[bringIn].Up[0]
+		if card.Rank() < lowest.Rank() || (card.Rank() == lowest.Rank() && cards.SuitOrder(card.Suit()) < cards.SuitOrder(lowest.Suit())) {
+			bringIn = id
+		}
+	}
+	return bringIn
+}
+
+// FirstToAct returns the id of the player who acts first on the most recently dealt street. On third
+// street this is the bring-in, and on later streets it is the player whose up cards make the best hand,
+// with ties going to the lowest id. It returns -1 before third street has been dealt.
+func (s StudDeal) FirstToAct() int {
+	if s.street <= ThirdStreet {
+		return s.BringIn()
+	}
+	first := 0
+	for id := range s.hands {
+		if cards.CompareHands(s.hands[id].Up, s.hands[first].Up) > 0 {
+			first = id
+		}
+	}
+	return first
+}
+
+// Deals a card to each player, face up or face down.
+func (s *StudDeal) dealToAll(faceUp bool) {
+	for id := range s.hands {
+		card, err := s.deck.Draw()
+		if err != nil {
+			panic(err)
+		}
+		if faceUp {
+			s.hands[id].Up = append(s.hands[id].Up, card)
+		} else {
+			s.hands[id].Down = append(s.hands[id].Down, card)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+func TestStudDealingSequence(t *testing.T) {
+	s, err := NewStudDeal(MaxStudPlayers, nil)
+	if err != nil {
+		t.Fatalf("Expected a stud deal for %v players not to return an error, but it returned %v.", MaxStudPlayers, err)
+	}
+	// number of cards each player has down and up after each street
+	expected := map[int][2]int{3: {2, 1}, 4: {2, 2}, 5: {2, 3}, 6: {2, 4}, 7: {3, 4}}
+	for street := ThirdStreet; street <= SeventhStreet; street++ {
+		if err := s.DealStreet(); err != nil {
+			t.Fatalf("Expected dealing street %v not to return an error, but it returned %v.", street, err)
+		}
+		if s.Street() != street {
+			t.Errorf("Expected the street to be %v, but instead it was %v.", street, s.Street())
+		}
+		for id := 0; id < MaxStudPlayers; id++ {
+			hand, _ := s.Hand(id)
+			if len(hand.Down) != expected[street][0] || len(hand.Up) != expected[street][1] {
+				t.Errorf("Expected player %v to have %v cards down and %v up on street %v, but instead they had %v and %v.",
+					id, expected[street][0], expected[street][1], street, len(hand.Down), len(hand.Up))
+			}
+		}
+	}
+	if err := s.DealStreet(); err == nil {
+		t.Errorf("Expected an error dealing past seventh street, but there wasn't one.")
+	}
+
+	seen := make(map[cards.Card]bool)
+	for id := 0; id < MaxStudPlayers; id++ {
+		hand, _ := s.Hand(id)
+		for _, c := range hand.Cards() {
+			if seen[c] {
+				t.Errorf("Expected every card dealt to be distinct, but %v was dealt twice.", c)
+			}
+			seen[c] = true
+		}
+	}
+}
+
+func TestStudBringInAndFirstToAct(t *testing.T) {
+	s, _ := NewStudDeal(3, nil)
+	if s.BringIn() != -1 || s.FirstToAct() != -1 {
+		t.Errorf("Expected no bring-in or first to act before the cards are dealt, but instead they were %v and %v.", s.BringIn(), s.FirstToAct())
+	}
+	s.DealStreet()
+	// Players 1 and 2 both show a two, and player 2's club is the lowest suit.
+	s.hands[0].Up = parseCards("Kh")
+	s.hands[1].Up = parseCards("2s")
+	s.hands[2].Up = parseCards("2c")
+	if s.BringIn() != 2 || s.FirstToAct() != 2 {
+		t.Errorf("Expected player 2 to bring it in and act first on third street, but instead it was %v and %v.", s.BringIn(), s.FirstToAct())
+	}
+	// On later streets the best showing hand acts first.
+	s.DealStreet()
+	s.hands[0].Up = parseCards("Kh Qd")
+	s.hands[1].Up = parseCards("2s 2d")
+	s.hands[2].Up = parseCards("2c Ah")
+	if s.FirstToAct() != 1 {
+		t.Errorf("Expected player 1's pair showing to act first on fourth street, but instead it was player %v.", s.FirstToAct())
+	}
+}
+
+func TestNewStudDealInvalidPlayers(t *testing.T) {
+	for _, numPlayers := range []int{1, MaxStudPlayers + 1} {
+		if _, err := NewStudDeal(numPlayers, nil); err == nil {
+			t.Errorf("Expected an error creating a stud deal for %v players, but there wasn't one.", numPlayers)
+		}
+	}
+	s, _ := NewStudDeal(2, nil)
+	if _, err := s.Hand(2); err == nil {
+		t.Errorf("Expected an error getting the hand of a player who doesn't exist, but there wasn't one.")
+	}
+}
+
+func TestNewStudDealSource(t *testing.T) {
+	source := cards.NewSource(42)
+	a, _ := NewStudDeal(4, source.Copy())
+	b, _ := NewStudDeal(4, source)
+	for street := ThirdStreet; street <= SeventhStreet; street++ {
+		a.DealStreet()
+		b.DealStreet()
+	}
+	for id := 0; id < 4; id++ {
+		handA, _ := a.Hand(id)
+		handB, _ := b.Hand(id)
+		if !cards.HandsEqual(handA.Cards(), handB.Cards()) {
+			t.Errorf("Expected player %v to be dealt the same cards from sources in the same state, but instead they were %v and %v.",
+				id, handA.Cards(), handB.Cards())
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Tournament runs several tables at once, moving players between tables as others are eliminated so
+// that the tables stay balanced, and breaking up tables once the remaining players fit at fewer tables.
+// Players are identified across the tournament by an entrant id, since their id at a table is the seat
+// they are sitting in and changes when they move.
+type Tournament struct {
+	tables     []*GameState
+	entrants   [][]int // entrant id sitting in each seat of each table, -1 for an empty seat
+	eliminated []int   // entrant ids in the order they were eliminated
+	numEntered int
+}
+
+// Standing is an entrant's position in a tournament.
+type Standing struct {
+	Entrant int
+	Place   int // 1 for the entrant in the lead
+	Money   int
+	Table   int // index of the table the entrant is sitting at, -1 if they have been eliminated
+	Seat    int // the entrant's player id at their table, -1 if they have been eliminated
+}
+
+// NewTournament creates a tournament with no tables.
+func NewTournament() *Tournament {
+	return &Tournament{[]*GameState{}, [][]int{}, []int{}, 0}
+}
+
+// RegisterTable adds a table to the tournament and returns its index. Each player still in the game at
+// the table is given the next entrant id in seat order. The tournament keeps the table, so hands played
+// on it are reflected in the tournament, and plays it as a TournamentGame.
+func (t *Tournament) RegisterTable(g *GameState) int {
+	g.rules.Mode = TournamentGame
+	seats := make([]int, len(g.table))
+	for i, p := range g.table {
+		seats[i] = -1
+		if p.alive {
+			seats[i] = t.numEntered
+			t.numEntered++
+		}
+	}
+	t.tables = append(t.tables, g)
+	t.entrants = append(t.entrants, seats)
+	return len(t.tables) - 1
+}
+
+// JoinTable seats a new entrant at the table with the specified index, as GameState.JoinTable does, and
+// returns their entrant id. Players who have run out of money are eliminated first, so a new entrant can
+// take the seat of a player who busted since the tournament was last rebalanced. It returns an error if
+// there is no table with the index or the player can't join the table.
+func (t *Tournament) JoinTable(tableIdx int, cfg PlayerConfig, postBlind bool) (int, error) {
+	g, err := t.Table(tableIdx)
+	if err != nil {
+		return -1, fmt.Errorf("error joining table: %v", err)
+	}
+	// players who have run out of money are eliminated before anyone can take their seat
+	t.eliminateBusted()
+	seat, err := g.seatPlayer(cfg, postBlind)
+	if err != nil {
+		return -1, err
+	}
+	if seat == len(t.entrants[tableIdx]) {
+		t.entrants[tableIdx] = append(t.entrants[tableIdx], -1)
+	}
+	t.entrants[tableIdx][seat] = t.numEntered
+	t.numEntered++
+	return t.entrants[tableIdx][seat], nil
+}
+
+// Table returns the table at the specified index.
+func (t *Tournament) Table(index int) (*GameState, error) {
+	if index < 0 || index >= len(t.tables) {
+		return nil, fmt.Errorf("there is no table with index %v", index)
+	}
+	return t.tables[index], nil
+}
+
+// Rebalance eliminates players who have run out of money and moves players between tables so that no
+// table has more than one player more than another. Tables are broken up once their players can be
+// seated at the other tables. It should be called between hands.
+func (t *Tournament) Rebalance() {
+	t.eliminateBusted()
+	for {
+		active := t.activeTables()
+		if len(active) < 2 {
+			return
+		}
+		smallest := active[0]
+		for _, index := range active {
+			if t.tables[index].AlivePlayerCount() < t.tables[smallest].AlivePlayerCount() {
+				smallest = index
+			}
+		}
+		if t.tables[smallest].AlivePlayerCount() > t.emptySeatsExcept(smallest) {
+			break
+		}
+		for t.tables[smallest].AlivePlayerCount() > 0 {
+			t.movePlayer(smallest, t.shortestTableExcept(smallest))
+		}
+	}
+	for {
+		active := t.activeTables()
+		if len(active) < 2 {
+			return
+		}
+		shortest, longest := active[0], active[0]
+		for _, index := range active {
+			count := t.tables[index].AlivePlayerCount()
+			if count < t.tables[shortest].AlivePlayerCount() {
+				shortest = index
+			}
+			if count > t.tables[longest].AlivePlayerCount() {
+				longest = index
+			}
+		}
+		if t.tables[longest].AlivePlayerCount()-t.tables[shortest].AlivePlayerCount() <= 1 || t.emptySeat(shortest) == -1 {
+			return
+		}
+		t.movePlayer(longest, shortest)
+	}
+}
+
+// MergeTables seats the players still in the game at two tables at a new table, with their stacks. The
+// players from table a take the first seats in the order they were sitting, followed by the players from
+// table b. The new table is played with table a's rules, blinds, and blind schedule, continuing from
+// table a's blind level, and shuffles from a copy of table a's source. It returns an error if the tables
+// have different big blinds, if either table is in the middle of a hand, or if the players don't fit at
+// one table of the table size of table a's rules.
+func MergeTables(a, b *GameState) (*GameState, error) {
+	if a.bigBlindAmount != b.bigBlindAmount {
+		return nil, fmt.Errorf("error merging tables: big blinds of $%v and $%v are different", a.bigBlindAmount, b.bigBlindAmount)
+	}
+	if a.handInProgress() || b.handInProgress() {
+		return nil, fmt.Errorf("error merging tables: tables can only be merged between hands")
+	}
+	players := append(a.alivePlayers(), b.alivePlayers()...)
+	if len(players) > a.rules.maxSeats() {
+		return nil, fmt.Errorf("error merging tables: %v players don't fit at a table of %v seats", len(players), a.rules.maxSeats())
+	}
+	// the small blind is passed explicitly since a blind level's big blind may not split into one
+	rules := a.rules
+	rules.SmallBlind = a.smallBlindAmount
+	merged, err := NewGameWithRules(len(players), 0, a.bigBlindAmount, rules, a.rng)
+	if err != nil {
+		return nil, fmt.Errorf("error merging tables: %v", err)
+	}
+	merged.clock = blindClock{append([]BlindLevel{}, a.clock.schedule...), a.clock.level, a.clock.elapsed}
+	for seat, p := range players {
+		merged.table[seat].money = p.money
+	}
+	return &merged, nil
+}
+
+// MergeTables merges the tables with the specified indexes, as the MergeTables function does, and
+// registers the merged table with the tournament, returning its index. Entrants keep their entrant ids
+// at the merged table, and the two tables are left without players. Players who have run out of money
+// are eliminated first. It returns an error if there is no table with either index, the indexes are the
+// same, or the tables can't be merged.
+func (t *Tournament) MergeTables(aIdx, bIdx int) (int, error) {
+	a, err := t.Table(aIdx)
+	if err != nil {
+		return -1, fmt.Errorf("error merging tables: %v", err)
+	}
+	b, err := t.Table(bIdx)
+	if err != nil {
+		return -1, fmt.Errorf("error merging tables: %v", err)
+	}
+	if aIdx == bIdx {
+		return -1, fmt.Errorf("error merging tables: can't merge table %v with itself", aIdx)
+	}
+	t.eliminateBusted()
+	merged, err := MergeTables(a, b)
+	if err != nil {
+		return -1, err
+	}
+	seats := []int{}
+	for _, tableIdx := range []int{aIdx, bIdx} {
+		for seat, p := range t.tables[tableIdx].table {
+			if p.alive {
+				seats = append(seats, t.entrants[tableIdx][seat])
+				t.tables[tableIdx].table[seat].alive = false
+				t.tables[tableIdx].table[seat].money = 0
+				t.entrants[tableIdx][seat] = -1
+			}
+		}
+	}
+	merged.rules.Mode = TournamentGame
+	t.tables = append(t.tables, merged)
+	t.entrants = append(t.entrants, seats)
+	return len(t.tables) - 1, nil
+}
+
+// Standings returns every entrant ordered by their place. Entrants still playing are ranked by the
+// money they have, followed by eliminated entrants with the most recently eliminated first.
+func (t Tournament) Standings() []Standing {
+	standings := []Standing{}
+	for tableIdx, seats := range t.entrants {
+		for seat, entrant := range seats {
+			if entrant != -1 {
+				standings = append(standings, Standing{entrant, 0, t.tables[tableIdx].table[seat].money, tableIdx, seat})
+			}
+		}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Money > standings[j].Money
+	})
+	for i := len(t.eliminated) - 1; i >= 0; i-- {
+		standings = append(standings, Standing{t.eliminated[i], 0, 0, -1, -1})
+	}
+	for i := range standings {
+		standings[i].Place = i + 1
+	}
+	return standings
+}
+
+// Removes players who have run out of money from their tables and records their elimination.
+func (t *Tournament) eliminateBusted() {
+	for tableIdx, g := range t.tables {
+		for seat, p := range g.table {
+			// the table may have already taken the player out of the game when it started a new hand
+			if t.entrants[tableIdx][seat] != -1 && p.money == 0 {
+				g.table[seat].alive = false
+				t.eliminated = append(t.eliminated, t.entrants[tableIdx][seat])
+				t.entrants[tableIdx][seat] = -1
+			}
+		}
+	}
+}
+
+// Moves the player in the last occupied seat of one table to an empty seat at another.
+func (t *Tournament) movePlayer(from, to int) {
+	fromSeat := -1
+	for seat, p := range t.tables[from].table {
+		if p.alive {
+			fromSeat = seat
+		}
+	}
+	toSeat := t.emptySeat(to)
+	if fromSeat == -1 || toSeat == -1 {
+		return
+	}
+	t.tables[to].table[toSeat].alive = true
+	t.tables[to].table[toSeat].money = t.tables[from].table[fromSeat].money
+	t.tables[from].table[fromSeat].alive = false
+	t.tables[from].table[fromSeat].money = 0
+	t.entrants[to][toSeat] = t.entrants[from][fromSeat]
+	t.entrants[from][fromSeat] = -1
+}
+
+// Returns the indexes of the tables that still have players.
+func (t Tournament) activeTables() []int {
+	active := []int{}
+	for i, g := range t.tables {
+		if g.AlivePlayerCount() > 0 {
+			active = append(active, i)
+		}
+	}
+	return active
+}
+
+// Returns the index of the active table with the fewest players that has an empty seat, other than the
+// excluded table, or -1 if there isn't one.
+func (t Tournament) shortestTableExcept(excluded int) int {
+	shortest := -1
+	for _, index := range t.activeTables() {
+		if index == excluded || t.emptySeat(index) == -1 {
+			continue
+		}
+		if shortest == -1 || t.tables[index].AlivePlayerCount() < t.tables[shortest].AlivePlayerCount() {
+			shortest = index
+		}
+	}
+	return shortest
+}
+
+// Returns the number of empty seats at the active tables other than the excluded table.
+func (t Tournament) emptySeatsExcept(excluded int) int {
+	empty := 0
+	for _, index := range t.activeTables() {
+		if index != excluded {
+			empty += len(t.tables[index].table) - t.tables[index].AlivePlayerCount()
+		}
+	}
+	return empty
+}
+
+// Returns the first empty seat at the table, or -1 if every seat is taken.
+func (t Tournament) emptySeat(tableIdx int) int {
+	for seat, p := range t.tables[tableIdx].table {
+		if !p.alive {
+			return seat
+		}
+	}
+	return -1
+}
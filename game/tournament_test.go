@@ -0,0 +1,283 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Chris-Behan/gopoker/cards"
+)
+
+// Returns the number of players still in the game at each table of the tournament.
+func tableCounts(tournament *Tournament) []int {
+	counts := []int{}
+	for _, g := range tournament.tables {
+		counts = append(counts, g.AlivePlayerCount())
+	}
+	return counts
+}
+
+func TestTournamentRebalance(t *testing.T) {
+	tournament := NewTournament()
+	for i := 0; i < 2; i++ {
+		g, _ := NewGame(4, 100, 4)
+		tournament.RegisterTable(&g)
+	}
+	first, _ := tournament.Table(0)
+	second, _ := tournament.Table(1)
+
+	// Three players at the first table bust, so players move over from the second table.
+	first.table[0].money = 0
+	first.table[1].money = 0
+	first.table[2].money = 0
+	second.table[3].money = 250
+	tournament.Rebalance()
+	if counts := tableCounts(tournament); !intSlicesEqual(counts, []int{2, 3}) {
+		t.Errorf("Expected the tables to be balanced with 2 and 3 players, but instead they had %v.", counts)
+	}
+	if !first.table[0].alive || first.table[0].money != 250 {
+		t.Errorf("Expected the player with 250 to move into the first empty seat of the first table, but they didn't.")
+	}
+
+	// Once the remaining players fit at one table the first table is broken up.
+	second.table[0].money = 0
+	tournament.Rebalance()
+	if counts := tableCounts(tournament); !intSlicesEqual(counts, []int{0, 4}) {
+		t.Errorf("Expected the first table to be broken up, leaving 0 and 4 players, but instead they had %v.", counts)
+	}
+
+	standings := tournament.Standings()
+	if len(standings) != 8 {
+		t.Fatalf("Expected 8 entrants in the standings, but instead there were %v.", len(standings))
+	}
+	// The leader is the entrant who moved tables, and the last player eliminated finished fifth.
+	if standings[0].Entrant != 7 || standings[0].Money != 250 || standings[0].Table != 1 {
+		t.Errorf("Expected entrant 7 to lead with 250 at table 1, but instead the leader was %+v.", standings[0])
+	}
+	if standings[4].Entrant != 4 || standings[4].Place != 5 || standings[4].Table != -1 {
+		t.Errorf("Expected entrant 4 to finish fifth after being eliminated last, but instead fifth was %+v.", standings[4])
+	}
+	totalMoney := 0
+	for _, standing := range standings {
+		totalMoney += standing.Money
+	}
+	if totalMoney != 550 {
+		t.Errorf("Expected the entrants to have 550 between them after moving tables, but instead they had %v.", totalMoney)
+	}
+}
+
+func TestTournamentTableInvalid(t *testing.T) {
+	tournament := NewTournament()
+	if _, err := tournament.Table(0); err == nil {
+		t.Errorf("Expected an error getting a table from a tournament without tables, but there wasn't one.")
+	}
+}
+
+func TestTournamentTableMode(t *testing.T) {
+	tournament := NewTournament()
+	g, _ := NewGame(3, 100, 4)
+	tournament.RegisterTable(&g)
+	if g.Mode() != TournamentGame {
+		t.Errorf("Expected a table registered with a tournament to be played as a tournament, but instead its mode was %v.", g.Mode())
+	}
+	// The table takes a busted player out of the game when it starts a hand, which the tournament still
+	// records as an elimination.
+	g.table[2].money = 0
+	g.newRound()
+	tournament.Rebalance()
+	if standings := tournament.Standings(); standings[len(standings)-1].Entrant != 2 || standings[len(standings)-1].Table != -1 {
+		t.Errorf("Expected entrant 2 to be eliminated, but instead the standings were %+v.", standings)
+	}
+}
+
+func TestMergeTables(t *testing.T) {
+	a, _ := NewGame(4, 100, 4)
+	b, _ := NewGame(3, 100, 4)
+	a.table[1].alive = false
+	a.table[1].money = 0
+	a.table[3].money = 180
+	b.table[0].money = 60
+	b.table[2].money = 75
+
+	merged, err := MergeTables(&a, &b)
+	if err != nil {
+		t.Fatalf("Expected the tables to merge, but instead got error %v.", err)
+	}
+	// The players of table a keep their order, followed by the players of table b.
+	expected := []int{100, 100, 180, 60, 100, 75}
+	if len(merged.table) != len(expected) || merged.AlivePlayerCount() != len(expected) {
+		t.Fatalf("Expected %v players at the merged table, but instead there were %v seats and %v players.",
+			len(expected), len(merged.table), merged.AlivePlayerCount())
+	}
+	for seat, money := range expected {
+		if stack, _ := merged.Stack(seat); stack != money {
+			t.Errorf("Expected seat %v of the merged table to have a stack of %v, but instead it was %v.", seat, money, stack)
+		}
+	}
+	merged.newRound()
+	if merged.ActivePlayerCount() != len(expected) {
+		t.Errorf("Expected every player to be dealt into a hand at the merged table, but %v were.", merged.ActivePlayerCount())
+	}
+}
+
+func TestMergeTablesClock(t *testing.T) {
+	a, _ := NewGameWithRules(3, 100, 4, Rules{}, cards.NewSource(42))
+	b, _ := NewGame(3, 100, 4)
+	a.SetBlindSchedule([]BlindLevel{{3, 4, 10 * time.Minute}, {5, 10, 10 * time.Minute}})
+	a.newRound()
+	a.DistributePot()
+	a.TickClock(4 * time.Minute)
+
+	merged, err := MergeTables(&a, &b)
+	if err != nil {
+		t.Fatalf("Expected the tables to merge, but instead got error %v.", err)
+	}
+	if merged.smallBlindAmount != 3 {
+		t.Errorf("Expected the merged table to keep table a's small blind of 3, but instead it was %v.", merged.smallBlindAmount)
+	}
+	if level, left := merged.CurrentBlindLevel(); level != 1 || left != 6*time.Minute {
+		t.Errorf("Expected the merged table to be on level 1 with 6m left, but instead it was on level %v with %v left.", level, left)
+	}
+	next := a.Clone()
+	next.newRound()
+	merged.TickClock(6 * time.Minute)
+	merged.newRound()
+	if merged.smallBlindAmount != 5 || merged.bigBlindAmount != 10 {
+		t.Errorf("Expected the merged table to move to blinds of 5/10, but instead they were %v/%v.", merged.smallBlindAmount, merged.bigBlindAmount)
+	}
+	if merged.Seed() != next.Seed() {
+		t.Errorf("Expected the merged table to shuffle from table a's source, but its seed was %v rather than %v.", merged.Seed(), next.Seed())
+	}
+}
+
+func TestMergeTablesInvalid(t *testing.T) {
+	full, _ := NewGame(MaxPlayers, 100, 4)
+	pair, _ := NewGame(2, 100, 4)
+	if _, err := MergeTables(&full, &pair); err == nil {
+		t.Errorf("Expected merging more than MaxPlayers players to return an error, but it didn't.")
+	}
+	higherBlinds, _ := NewGame(2, 100, 10)
+	if _, err := MergeTables(&pair, &higherBlinds); err == nil {
+		t.Errorf("Expected merging tables with different big blinds to return an error, but it didn't.")
+	}
+	inHand, _ := NewGame(2, 100, 4)
+	inHand.newRound()
+	if _, err := MergeTables(&pair, &inHand); err == nil {
+		t.Errorf("Expected merging a table in the middle of a hand to return an error, but it didn't.")
+	}
+}
+
+func TestMergeTablesOddBigBlind(t *testing.T) {
+	a, _ := NewGame(3, 100, 4)
+	b, _ := NewGameWithBlinds(3, 100, 2, 5)
+	a.SetBlindSchedule([]BlindLevel{{2, 5, 10 * time.Minute}})
+	a.newRound()
+	a.DistributePot()
+
+	merged, err := MergeTables(&a, &b)
+	if err != nil {
+		t.Fatalf("Expected tables with a big blind of 5 to merge, but instead got error %v.", err)
+	}
+	if merged.smallBlindAmount != 2 || merged.bigBlindAmount != 5 {
+		t.Errorf("Expected the merged table to have blinds of 2/5, but instead they were %v/%v.", merged.smallBlindAmount, merged.bigBlindAmount)
+	}
+}
+
+func TestTournamentMergeTables(t *testing.T) {
+	tournament := NewTournament()
+	a, _ := NewGame(3, 100, 4)
+	b, _ := NewGame(3, 100, 4)
+	tournament.RegisterTable(&a)
+	tournament.RegisterTable(&b)
+	a.table[1].money = 0
+	b.table[2].money = 150
+
+	index, err := tournament.MergeTables(0, 1)
+	if err != nil {
+		t.Fatalf("Expected the tables to merge, but instead got error %v.", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected the merged table to have index 2, but instead it was %v.", index)
+	}
+	// Entrant 1 busted, and the others sit at the merged table in the order of their old tables.
+	expected := []Standing{{5, 1, 150, 2, 4}, {0, 2, 100, 2, 0}, {2, 3, 100, 2, 1}, {3, 4, 100, 2, 2}, {4, 5, 100, 2, 3}, {1, 6, 0, -1, -1}}
+	if standings := tournament.Standings(); !reflect.DeepEqual(standings, expected) {
+		t.Errorf("Expected standings %v, but instead they were %v.", expected, standings)
+	}
+	if a.AlivePlayerCount() != 0 || b.AlivePlayerCount() != 0 {
+		t.Errorf("Expected the merged tables to be left without players, but they had %v and %v.", a.AlivePlayerCount(), b.AlivePlayerCount())
+	}
+	if merged, _ := tournament.Table(index); merged.rules.Mode != TournamentGame {
+		t.Errorf("Expected the merged table to be played as a TournamentGame, but instead it was %v.", merged.rules.Mode)
+	}
+	if _, err := tournament.MergeTables(2, 2); err == nil {
+		t.Errorf("Expected merging a table with itself to return an error, but it didn't.")
+	}
+	if _, err := tournament.MergeTables(2, 3); err == nil {
+		t.Errorf("Expected merging a table that doesn't exist to return an error, but it didn't.")
+	}
+}
+
+func TestTournamentJoinTable(t *testing.T) {
+	tournament := NewTournament()
+	g, _ := NewGame(3, 100, 4)
+	tournament.RegisterTable(&g)
+	if _, err := g.JoinTable(PlayerConfig{Stack: 100}, true); err == nil {
+		t.Errorf("Expected joining a tournament table without the tournament to return an error, but it didn't.")
+	}
+	if _, err := tournament.JoinTable(1, PlayerConfig{Stack: 100}, true); err == nil {
+		t.Errorf("Expected joining a table that doesn't exist to return an error, but it didn't.")
+	}
+
+	// Every seat is taken, so the player joins in a new seat, and then another player is eliminated and a
+	// player joins in their seat.
+	entrant, err := tournament.JoinTable(0, PlayerConfig{Stack: 120}, true)
+	if err != nil || entrant != 3 {
+		t.Fatalf("Expected the player to join as entrant 3, but instead got entrant %v and error %v.", entrant, err)
+	}
+	g.table[1].money = 0
+	tournament.Rebalance()
+	entrant, err = tournament.JoinTable(0, PlayerConfig{Stack: 90}, true)
+	if err != nil || entrant != 4 {
+		t.Fatalf("Expected the player to join as entrant 4, but instead got entrant %v and error %v.", entrant, err)
+	}
+	g.table[3].money = 0
+	tournament.Rebalance()
+
+	standings := tournament.Standings()
+	if len(standings) != 5 {
+		t.Fatalf("Expected 5 entrants in the standings, but instead there were %v.", len(standings))
+	}
+	if standings[2].Entrant != 4 || standings[2].Seat != 1 || standings[2].Money != 90 {
+		t.Errorf("Expected entrant 4 to be third with 90 in seat 1, but instead third was %+v.", standings[2])
+	}
+	if standings[3].Entrant != 3 || standings[3].Table != -1 || standings[4].Entrant != 1 {
+		t.Errorf("Expected entrants 3 and 1 to finish last after being eliminated, but instead the standings were %+v.", standings)
+	}
+}
+
+func TestTournamentJoinBustedSeat(t *testing.T) {
+	tournament := NewTournament()
+	g, _ := NewGame(3, 100, 4)
+	tournament.RegisterTable(&g)
+	// The table takes player 1 out of the game when it starts a hand, before the tournament rebalances.
+	g.table[1].money = 0
+	g.newRound()
+	g.DistributePot()
+	entrant, err := tournament.JoinTable(0, PlayerConfig{Stack: 100}, false)
+	if err != nil || entrant != 3 {
+		t.Fatalf("Expected the player to join as entrant 3, but instead got entrant %v and error %v.", entrant, err)
+	}
+	standings := tournament.Standings()
+	if len(standings) != 4 {
+		t.Fatalf("Expected 4 entrants in the standings, but instead there were %v.", len(standings))
+	}
+	if last := standings[3]; last.Entrant != 1 || last.Table != -1 {
+		t.Errorf("Expected entrant 1 to finish last after busting, but instead last was %+v.", last)
+	}
+	for _, standing := range standings {
+		if standing.Entrant == 3 && (standing.Table != 0 || standing.Seat != 1) {
+			t.Errorf("Expected entrant 3 to take seat 1, but instead they were %+v.", standing)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/Chris-Behan/gopoker
 
-go 1.17
+go 1.18
@@ -1,21 +1,207 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/Chris-Behan/gopoker/cards"
+	"github.com/Chris-Behan/gopoker/game"
 )
 
+const (
+	numPlayers    = 4
+	startingStack = 200
+	bigBlind      = 4
+	humanID       = 0
+)
+
+// Plays hands of Texas Hold'em between a human at the terminal and simple bots until someone busts.
 func main() {
-	deck1 := cards.GenerateDeck()
-	fmt.Printf("Deck1: %v\n", deck1)
-	c := deck1.GetCards()
-	fmt.Printf("Number of cards in deck: %v", len(c))
-	// for n := 0; n < 52; n++ {
-	// 	card, err := deck1.Draw()
-	// 	if err != nil {
-	// 		panic(err)
-	// 	}
-	// 	fmt.Printf("Draw %v: %v", n, card)
-	// }
+	g, err := game.NewGame(numPlayers, startingStack, bigBlind)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	agents := map[int]game.PlayerAgent{humanID: humanAgent(bufio.NewScanner(os.Stdin))}
+	for id := 0; id < numPlayers; id++ {
+		if id != humanID {
+			agents[id] = announced(botAgent)
+		}
+	}
+	for hand := 1; ; hand++ {
+		g.StartHand()
+		fmt.Printf("\n========== Hand %v ==========\n", hand)
+		result, err := g.RunToShowdown(agents)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		printResult(g, result)
+		for id := 0; id < numPlayers; id++ {
+			if stack, _ := g.Stack(id); stack == 0 && id == humanID {
+				fmt.Printf("\nYou are out of money after %v hands.\n", hand)
+				return
+			} else if stack == 0 {
+				fmt.Printf("\n%v is out of money after %v hands.\n", playerName(id), hand)
+				return
+			}
+		}
+	}
+}
+
+// Returns an agent that shows the human their view of the game and asks for their action, until they
+// enter one that is valid.
+func humanAgent(scanner *bufio.Scanner) game.PlayerAgent {
+	return func(g game.GameState, playerID int) game.Action {
+		view, _ := g.ViewFor(playerID)
+		printView(view)
+		if min, max := g.BetRange(playerID); max > 0 {
+			fmt.Printf("You can bet between $%v and $%v.\n", min, max)
+		}
+		if min, max := g.RaiseRange(playerID); max > 0 {
+			fmt.Printf("You can raise by between $%v and $%v, on top of the call.\n", min, max)
+		}
+		for {
+			fmt.Print("Your action (fold, check, call, bet <amount>, raise <amount>): ")
+			if !scanner.Scan() {
+				fmt.Println()
+				os.Exit(0)
+			}
+			action, err := parseAction(scanner.Text())
+			if err == nil {
+				_, err = g.ApplyImmutable(playerID, action)
+			}
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			return action
+		}
+	}
+}
+
+// Parses an action typed by the human. Ex. "call" or "raise 20"
+func parseAction(input string) (game.Action, error) {
+	fields := strings.Fields(strings.ToLower(input))
+	if len(fields) == 0 {
+		return game.Action{}, fmt.Errorf("enter an action")
+	}
+	types := map[string]game.ActionType{
+		"fold":  game.FoldAction,
+		"check": game.CheckAction,
+		"call":  game.CallAction,
+		"bet":   game.BetAction,
+		"raise": game.RaiseAction,
+	}
+	actionType, ok := types[fields[0]]
+	if !ok {
+		return game.Action{}, fmt.Errorf("%q is not an action", fields[0])
+	}
+	action := game.Action{Type: actionType}
+	if actionType == game.BetAction || actionType == game.RaiseAction {
+		if len(fields) != 2 {
+			return game.Action{}, fmt.Errorf("%v needs an amount, Ex. %v 10", actionType, actionType)
+		}
+		amount, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return game.Action{}, fmt.Errorf("%q is not an amount", fields[1])
+		}
+		action.Amount = amount
+	}
+	return action, nil
+}
+
+// Bets or raises the minimum with a strong hand, folds a weak hand when calling costs more than the
+// pot, and otherwise checks or calls.
+func botAgent(g game.GameState, playerID int) game.Action {
+	view, _ := g.ViewFor(playerID)
+	category := cards.Category(append(view.HoleCards[playerID], view.Community...))
+	strong := category >= cards.TwoPair || (view.Phase == game.PreFlop && category == cards.Pair)
+	if strong {
+		if min, max := g.BetRange(playerID); max > 0 {
+			return game.Action{Type: game.BetAction, Amount: min}
+		}
+		if min, max := g.RaiseRange(playerID); max > 0 {
+			return game.Action{Type: game.RaiseAction, Amount: min}
+		}
+	}
+	check := game.Action{Type: game.CheckAction}
+	if _, err := g.ApplyImmutable(playerID, check); err == nil {
+		return check
+	}
+	call := game.Action{Type: game.CallAction}
+	before, _ := g.Stack(playerID)
+	afterCall, _ := g.ApplyImmutable(playerID, call)
+	after, _ := afterCall.Stack(playerID)
+	if category == cards.HighCard && before-after > view.Pot {
+		return game.Action{Type: game.FoldAction}
+	}
+	return call
+}
+
+// Wraps an agent so that the action it chooses is printed.
+func announced(agent game.PlayerAgent) game.PlayerAgent {
+	return func(g game.GameState, playerID int) game.Action {
+		action := agent(g, playerID)
+		if action.Type == game.BetAction || action.Type == game.RaiseAction {
+			fmt.Printf("%v %vs $%v.\n", playerName(playerID), action.Type, action.Amount)
+		} else {
+			fmt.Printf("%v %vs.\n", playerName(playerID), action.Type)
+		}
+		return action
+	}
+}
+
+// Prints what the player can see of the game.
+func printView(view game.PlayerView) {
+	fmt.Printf("\n--- %v, pot $%v ---\n", view.Phase, view.Pot)
+	if len(view.Community) > 0 {
+		fmt.Println("Board:")
+		fmt.Println(cards.RenderHandANSI(view.Community))
+	}
+	fmt.Println("Your cards:")
+	fmt.Println(cards.RenderHandANSI(view.HoleCards[view.Viewer]))
+	ids := []int{}
+	for id := range view.Stacks {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		fmt.Printf("%v: $%v\n", playerName(id), view.Stacks[id])
+	}
+}
+
+// Prints the hands shown at showdown and who won the pot.
+func printResult(g game.GameState, result game.ShowdownResult) {
+	if g.ActivePlayerCount() > 1 {
+		ranking, _ := g.ShowdownRanking()
+		fmt.Println("\nShowdown:")
+		for _, r := range ranking {
+			fmt.Printf("%v: %v\n", playerName(r.PlayerID), r.Result.Description)
+			fmt.Println(cards.RenderHandANSI(r.Result.Cards))
+		}
+	}
+	ids := []int{}
+	for id := range result.Winnings {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if id == humanID {
+			fmt.Printf("You win $%v.\n", result.Winnings[id])
+		} else {
+			fmt.Printf("%v wins $%v.\n", playerName(id), result.Winnings[id])
+		}
+	}
+}
+
+func playerName(id int) string {
+	if id == humanID {
+		return "You"
+	}
+	return fmt.Sprintf("Bot %v", id)
 }